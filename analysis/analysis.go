@@ -0,0 +1,195 @@
+// Package analysis measures the properties of transition curves such as
+// velocity, overshoot and monotonicity, to help choose between curves.
+package analysis
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gopackage/tween"
+)
+
+//go:generate go run gen/gen.go
+
+// Samples is the number of evenly spaced points used when scanning a curve.
+const Samples = 1000
+
+// step is the distance used to estimate derivatives.
+const step = 1e-5
+
+// Velocity estimates the rate of change of the transition at completed. A
+// linear transition has a velocity of 1 everywhere.
+func Velocity(fn tween.TransitionFunc, completed float64) float64 {
+	lo, hi := bracket(completed)
+	return (fn(hi) - fn(lo)) / (hi - lo)
+}
+
+// Acceleration estimates the rate of change of the velocity at completed.
+func Acceleration(fn tween.TransitionFunc, completed float64) float64 {
+	lo, hi := bracket(completed)
+	mid := (lo + hi) / 2
+	h := (hi - lo) / 2
+	return (fn(hi) - 2*fn(mid) + fn(lo)) / (h * h)
+}
+
+// bracket finds the points either side of completed used for derivatives,
+// staying within 0.0 - 1.0 so curves are not evaluated outside their range.
+func bracket(completed float64) (lo, hi float64) {
+	lo, hi = completed-step, completed+step
+	if lo < 0 {
+		lo, hi = 0, 2*step
+	}
+	if hi > 1 {
+		lo, hi = 1-2*step, 1
+	}
+	return lo, hi
+}
+
+// Extent scans the transition for its smallest and largest values and where
+// they occur.
+func Extent(fn tween.TransitionFunc) (min, minAt, max, maxAt float64) {
+	min, max = math.Inf(1), math.Inf(-1)
+	for i := 0; i <= Samples; i++ {
+		x := float64(i) / Samples
+		y := fn(x)
+		if y < min {
+			min, minAt = y, x
+		}
+		if y > max {
+			max, maxAt = y, x
+		}
+	}
+	return min, minAt, max, maxAt
+}
+
+// Overshoot measures how far the transition goes below 0 (under) and above 1
+// (over), such as the anticipation of EaseInBack or the wobble of
+// EaseOutElastic. Both are 0 for curves that stay within range.
+func Overshoot(fn tween.TransitionFunc) (under, over float64) {
+	min, _, max, _ := Extent(fn)
+	return math.Max(0, -min), math.Max(0, max-1)
+}
+
+// Monotonic reports if the transition never moves backwards.
+func Monotonic(fn tween.TransitionFunc) bool {
+	prev := fn(0)
+	for i := 1; i <= Samples; i++ {
+		y := fn(float64(i) / Samples)
+		if y < prev {
+			return false
+		}
+		prev = y
+	}
+	return true
+}
+
+// ExactEndpoints reports if the transition starts exactly at 0 and ends
+// exactly at 1, so a tween lands precisely on its start and end values.
+func ExactEndpoints(fn tween.TransitionFunc) bool {
+	return fn(0) == 0 && fn(1) == 1
+}
+
+// Inverse finds the first completed value where the transition reaches
+// transitioned. ok is false if the transition never reaches the value.
+func Inverse(fn tween.TransitionFunc, transitioned float64) (completed float64, ok bool) {
+	lo, ylo := 0., fn(0)-transitioned
+	if ylo == 0 {
+		return 0, true
+	}
+	for i := 1; i <= Samples; i++ {
+		hi := float64(i) / Samples
+		yhi := fn(hi) - transitioned
+		if yhi == 0 {
+			return hi, true
+		}
+		if (ylo < 0) != (yhi < 0) {
+			// Bisect the interval that crosses the value
+			for n := 0; n < 64 && hi-lo > 1e-15; n++ {
+				mid := (lo + hi) / 2
+				ymid := fn(mid) - transitioned
+				if (ymid < 0) == (ylo < 0) {
+					lo, ylo = mid, ymid
+				} else {
+					hi = mid
+				}
+			}
+			return (lo + hi) / 2, true
+		}
+		lo, ylo = hi, yhi
+	}
+	return 0, false
+}
+
+// Properties summarizes the behavior of a transition curve.
+type Properties struct {
+	Name        string  // Name identifies the curve.
+	Exact       bool    // Exact is true when the curve starts at exactly 0 and ends at exactly 1.
+	Monotonic   bool    // Monotonic is true when the curve never moves backwards.
+	Min         float64 // Min is the smallest value of the curve.
+	Max         float64 // Max is the largest value of the curve.
+	Undershoot  float64 // Undershoot is how far the curve goes below 0.
+	Overshoot   float64 // Overshoot is how far the curve goes above 1.
+	StartSpeed  float64 // StartSpeed is the velocity at the start of the curve.
+	EndSpeed    float64 // EndSpeed is the velocity at the end of the curve.
+	MaxVelocity float64 // MaxVelocity is the largest absolute velocity of the curve.
+	Midpoint    float64 // Midpoint is the completed value where the curve first reaches 0.5.
+}
+
+// Analyze measures all of the properties of a curve.
+func Analyze(name string, fn tween.TransitionFunc) Properties {
+	p := Properties{
+		Name:       name,
+		Exact:      ExactEndpoints(fn),
+		Monotonic:  Monotonic(fn),
+		StartSpeed: Velocity(fn, 0),
+		EndSpeed:   Velocity(fn, 1),
+	}
+	p.Min, _, p.Max, _ = Extent(fn)
+	p.Undershoot, p.Overshoot = Overshoot(fn)
+	for i := 0; i <= Samples; i++ {
+		p.MaxVelocity = math.Max(p.MaxVelocity, math.Abs(Velocity(fn, float64(i)/Samples)))
+	}
+	p.Midpoint, _ = Inverse(fn, .5)
+	return p
+}
+
+// WriteTable writes the properties as a Markdown table.
+func WriteTable(w io.Writer, props []Properties) error {
+	_, err := fmt.Fprintln(w, "| Curve | Exact | Monotonic | Min | Max | Undershoot | Overshoot | Start speed | End speed | Max velocity | Midpoint |")
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintln(w, "|---|---|---|--:|--:|--:|--:|--:|--:|--:|--:|"); err != nil {
+		return err
+	}
+	for _, p := range props {
+		_, err = fmt.Fprintf(w, "| %s | %s | %s | %s | %s | %s | %s | %s | %s | %s | %s |\n",
+			p.Name, yes(p.Exact), yes(p.Monotonic), fixed(p.Min, 4), fixed(p.Max, 4),
+			fixed(p.Undershoot, 4), fixed(p.Overshoot, 4), fixed(p.StartSpeed, 3),
+			fixed(p.EndSpeed, 3), fixed(p.MaxVelocity, 3), fixed(p.Midpoint, 4))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fixed formats v with prec decimals, dropping the sign of values that round
+// to zero.
+func fixed(v float64, prec int) string {
+	s := strconv.FormatFloat(v, 'f', prec, 64)
+	if strings.Trim(s, "-0.") == "" {
+		return s[strings.IndexByte(s, '0'):]
+	}
+	return s
+}
+
+func yes(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
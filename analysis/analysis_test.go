@@ -0,0 +1,64 @@
+package analysis_test
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/gopackage/tween/analysis"
+	"github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Analysis", func() {
+	It("should measure velocity and acceleration", func() {
+		Ω(Velocity(curves.Linear, .5)).Should(BeNumerically("~", 1, 1e-6))
+		Ω(Velocity(curves.EaseInQuad, .5)).Should(BeNumerically("~", 1, 1e-6))
+		Ω(Velocity(curves.EaseInQuad, 1)).Should(BeNumerically("~", 2, 1e-4))
+		Ω(Velocity(curves.EaseInQuad, 0)).Should(BeNumerically("~", 0, 1e-4))
+		Ω(Acceleration(curves.EaseInQuad, .5)).Should(BeNumerically("~", 2, 1e-3))
+		Ω(Acceleration(curves.Linear, 0)).Should(BeNumerically("~", 0, 1e-3))
+	})
+	It("should measure overshoot", func() {
+		under, over := Overshoot(curves.EaseOutBack)
+		Ω(under).Should(Equal(0.))
+		Ω(over).Should(BeNumerically("~", .1317, 1e-4))
+		under, over = Overshoot(curves.EaseInQuad)
+		Ω(under).Should(Equal(0.))
+		Ω(over).Should(Equal(0.))
+		min, minAt, _, _ := Extent(curves.EaseInBack)
+		Ω(min).Should(BeNumerically("~", -32./243, 1e-6))
+		Ω(minAt).Should(BeNumerically("~", 4./9, 1e-3))
+	})
+	It("should detect monotonic curves", func() {
+		Ω(Monotonic(curves.EaseInOutCubic)).Should(BeTrue())
+		Ω(Monotonic(curves.EaseInBack)).Should(BeFalse())
+		Ω(Monotonic(curves.EaseOutBounce)).Should(BeFalse())
+	})
+	It("should check end points", func() {
+		Ω(ExactEndpoints(curves.EaseInQuad)).Should(BeTrue())
+		Ω(ExactEndpoints(curves.Remap(curves.Linear, 0, .9))).Should(BeFalse())
+	})
+	It("should invert curves", func() {
+		x, ok := Inverse(curves.EaseInQuad, .25)
+		Ω(ok).Should(BeTrue())
+		Ω(x).Should(BeNumerically("~", .5, 1e-12))
+		x, ok = Inverse(curves.EaseInBack, 0)
+		Ω(ok).Should(BeTrue())
+		Ω(x).Should(Equal(0.))
+		x, ok = Inverse(curves.EaseOutElastic, 1)
+		Ω(ok).Should(BeTrue())
+		Ω(x).Should(BeNumerically("<", .2))
+		_, ok = Inverse(curves.Linear, 2)
+		Ω(ok).Should(BeFalse())
+	})
+	It("should write a property table", func() {
+		out := bytes.Buffer{}
+		err := WriteTable(&out, []Properties{Analyze("easeInBack", curves.EaseInBack)})
+		Ω(err).Should(BeNil())
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Ω(lines).Should(HaveLen(3))
+		Ω(lines[2]).Should(HavePrefix("| easeInBack | yes | no | -0.1317 | 1.0000 | 0.1317 | 0.0000 | 0.000 | 5.000 |"))
+	})
+})
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+
+	"github.com/gopackage/tween/analysis"
+	"github.com/gopackage/tween/curves"
+)
+
+// Must will panic if there is an error. Use Must to wrap functions that
+// return an error that you know won't occur or is fatal if it does.
+func Must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func main() {
+	props := []analysis.Properties{}
+	for _, name := range curves.Names() {
+		// Only the Penner curves generated into curves/ease.go
+		if !strings.HasPrefix(name, "easeIn") && !strings.HasPrefix(name, "easeOut") {
+			continue
+		}
+		fn, _ := curves.Lookup(name)
+		props = append(props, analysis.Analyze(name, fn))
+	}
+
+	out := bytes.Buffer{}
+	out.WriteString(`# Curve properties
+
+Auto-generated file - do not edit directly! See source in analysis/gen/gen.go
+
+Properties of every curve in curves/ease.go measured with the analysis
+package. Speeds are relative to a linear transition (1.0) and the midpoint is
+the completed value where the curve first reaches 0.5.
+
+`)
+	Must(analysis.WriteTable(&out, props))
+	Must(ioutil.WriteFile("PROPERTIES.md", out.Bytes(), 0644))
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gopackage/tween"
+	"github.com/gopackage/tween/plot"
+)
+
+// progressBar is a tween.Updater that draws a progress bar on a terminal.
+type progressBar struct {
+	out   io.Writer
+	width int
+	done  chan int
+}
+
+// Start begins the progress bar.
+func (p *progressBar) Start(framerate, frames int, frameTime, runningTime time.Duration) {}
+
+// Update redraws the progress bar at the transitioned position, allowing
+// for curves that overshoot the end.
+func (p *progressBar) Update(frame tween.Frame) {
+	filled := int(math.Round(frame.Transitioned * float64(p.width)))
+	if filled < 0 {
+		filled = 0
+	}
+	if filled > p.width {
+		filled = p.width
+	}
+	fmt.Fprintf(p.out, "\r[%s%s] %4.0f%% %v   ", strings.Repeat("=", filled), strings.Repeat(" ", p.width-filled),
+		frame.Transitioned*100, frame.Elapsed.Round(time.Millisecond))
+}
+
+// End finishes the progress bar line.
+func (p *progressBar) End() {
+	fmt.Fprintln(p.out)
+	close(p.done)
+}
+
+// animate runs a real tween.Engine driving a progress bar and waits for it.
+// A positive threshold lets the engine drop to 5 fps while the bar is slow.
+func animate(out io.Writer, series plot.Series, duration time.Duration, width int, fps, threshold float64) error {
+	if width < 1 || fps <= 0 {
+		return fmt.Errorf("animate needs a positive width and fps")
+	}
+	fmt.Fprintln(out, series.Name)
+	bar := &progressBar{out: out, width: width, done: make(chan int)}
+	engine := tween.NewEngine(duration, series.Func, bar)
+	engine.Rate = fps
+	if threshold > 0 {
+		engine.Adaptive = &tween.Adaptive{MinRate: 5, Threshold: threshold}
+	}
+	engine.Start()
+	<-bar.done
+	return nil
+}
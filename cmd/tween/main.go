@@ -0,0 +1,155 @@
+// Command tween inspects the transition curves in the curves package from
+// the terminal: list them, sample values, plot them, animate a progress bar
+// and export images.
+//
+// Curves are given by name or easing expression (see curves.Parse), e.g.
+//
+//	tween list
+//	tween sample -n 5 -format json easeOutBounce
+//	tween plot "cubic-bezier(.17,.67,.83,.67)"
+//	tween animate -duration 2s easeInOutBack
+//	tween export -format svg -o out.svg easeInQuad easeOutQuad
+//	tween export -gallery ./gallery
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gopackage/tween/curves"
+	"github.com/gopackage/tween/plot"
+)
+
+const usage = `usage: tween <command> [flags] [curve ...]
+
+commands:
+  list      list the registered curve names
+  sample    print sampled curve values as CSV or JSON
+  plot      draw the curves in the terminal
+  animate   animate a progress bar with a tween
+  export    write PNG or SVG plots of the curves
+
+Run "tween <command> -h" for the flags of a command.
+`
+
+// errUsage signals that usage has already been printed.
+var errUsage = errors.New("usage")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		if err != errUsage && err != flag.ErrHelp {
+			fmt.Fprintln(os.Stderr, "tween:", err)
+		}
+		os.Exit(2)
+	}
+}
+
+// run executes the command in args, writing output, usage and flag help
+// to out.
+func run(args []string, out io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(out, usage)
+		return errUsage
+	}
+	cmd, args := args[0], args[1:]
+	flags := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	flags.SetOutput(out)
+	switch cmd {
+	case "list":
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		for _, name := range curves.Names() {
+			fmt.Fprintln(out, name)
+		}
+		return nil
+	case "sample":
+		n := flags.Int("n", 11, "number of samples")
+		format := flags.String("format", "csv", "output format: csv or json")
+		series, err := parse(flags, args)
+		if err != nil {
+			return err
+		}
+		return sample(out, series, *n, *format)
+	case "plot":
+		width := flags.Int("width", 60, "plot width in characters")
+		height := flags.Int("height", 20, "plot height in lines")
+		ascii := flags.Bool("ascii", false, "use only ASCII characters")
+		series, err := parse(flags, args)
+		if err != nil {
+			return err
+		}
+		return terminalPlot(out, series, *width, *height, *ascii)
+	case "animate":
+		duration := flags.Duration("duration", 2*time.Second, "duration of the tween")
+		width := flags.Int("width", 40, "progress bar width in characters")
+		fps := flags.Float64("fps", 30, "frames per second, e.g. 29.97")
+		adaptive := flags.Float64("adaptive", 0, "lower the framerate while the bar moves less than this fraction per frame")
+		series, err := parse(flags, args)
+		if err != nil {
+			return err
+		}
+		if len(series) != 1 {
+			return errors.New("animate needs exactly one curve")
+		}
+		return animate(out, series[0], *duration, *width, *fps, *adaptive)
+	case "export":
+		format := flags.String("format", "png", "image format: png or svg")
+		output := flags.String("o", "", "output file (defaults to standard output)")
+		gallery := flags.String("gallery", "", "write every registered curve into this directory")
+		width := flags.Int("width", plot.DefaultOptions.Width, "image width in pixels")
+		height := flags.Int("height", plot.DefaultOptions.Height, "image height in pixels")
+		trail := flags.Int("trail", 0, "number of motion trail dots")
+		shade := flags.Bool("shade", true, "shade overshoot regions")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		opts := plot.Options{Width: *width, Height: *height, Trail: *trail, NoShade: !*shade}
+		if *gallery != "" {
+			return plot.Gallery(*gallery, plot.Format(*format), opts)
+		}
+		series, err := parse(flags, nil)
+		if err != nil {
+			return err
+		}
+		if *output == "" {
+			return plot.Write(out, plot.Format(*format), series, opts)
+		}
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := plot.Write(f, plot.Format(*format), series, opts); err != nil {
+			return err
+		}
+		return f.Close()
+	}
+	fmt.Fprint(out, usage)
+	return errUsage
+}
+
+// parse parses the flags (when args is not nil) and the curves that follow.
+func parse(flags *flag.FlagSet, args []string) ([]plot.Series, error) {
+	if args != nil {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+	}
+	if flags.NArg() == 0 {
+		return nil, fmt.Errorf("%s needs at least one curve", flags.Name())
+	}
+	series := []plot.Series{}
+	for _, spec := range flags.Args() {
+		fn, err := curves.Parse(spec)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, plot.Series{Name: spec, Func: fn})
+	}
+	return series, nil
+}
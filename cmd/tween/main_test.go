@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Command", func() {
+	var out *bytes.Buffer
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+	})
+
+	It("should list the registered curves", func() {
+		Ω(run([]string{"list"}, out)).Should(Succeed())
+		Ω(strings.Split(out.String(), "\n")).Should(ContainElement("easeInOutQuad"))
+	})
+	It("should sample curves as CSV", func() {
+		Ω(run([]string{"sample", "-n", "3", "easeInQuad", "linear"}, out)).Should(Succeed())
+		Ω(out.String()).Should(Equal("completed,easeInQuad,linear\n0,0,0\n0.5,0.25,0.5\n1,1,1\n"))
+	})
+	It("should sample curves as JSON", func() {
+		Ω(run([]string{"sample", "-n", "2", "-format", "json", "steps(2)"}, out)).Should(Succeed())
+		var all []samples
+		Ω(json.Unmarshal(out.Bytes(), &all)).Should(Succeed())
+		Ω(all).Should(Equal([]samples{{"steps(2)", []point{{0, 0}, {1, 1}}}}))
+	})
+	It("should plot curves in the terminal", func() {
+		Ω(run([]string{"plot", "-ascii", "-width", "10", "-height", "5", "linear"}, out)).Should(Succeed())
+		lines := strings.Split(out.String(), "\n")
+		Ω(lines[0]).Should(Equal("* linear"))
+		Ω(lines[1]).Should(Equal(" 1.00 |        **"))
+		Ω(lines[5]).Should(Equal(" 0.00 |**        "))
+		Ω(lines[6]).Should(Equal("      +----------"))
+	})
+	It("should animate a progress bar", func() {
+		Ω(run([]string{"animate", "-duration", "100ms", "-width", "10", "easeInQuad"}, out)).Should(Succeed())
+		Ω(run([]string{"animate", "-duration", "100ms", "-fps", "29.97", "-adaptive", ".01", "linear"}, out)).Should(Succeed())
+		Ω(out.String()).Should(ContainSubstring("[==========]  100%"))
+	})
+	It("should export plots", func() {
+		Ω(run([]string{"export", "-format", "svg", "easeInQuad"}, out)).Should(Succeed())
+		Ω(out.String()).Should(HavePrefix("<svg"))
+	})
+	It("should report bad curves", func() {
+		Ω(run([]string{"sample", "wobble"}, out)).ShouldNot(Succeed())
+		Ω(run([]string{"sample"}, out)).ShouldNot(Succeed())
+		Ω(run([]string{"animate", "linear", "linear"}, out)).ShouldNot(Succeed())
+	})
+	It("should write usage to the output", func() {
+		Ω(run(nil, out)).Should(Equal(errUsage))
+		Ω(out.String()).Should(HavePrefix("usage: tween"))
+		out.Reset()
+		Ω(run([]string{"wobble"}, out)).Should(Equal(errUsage))
+		Ω(out.String()).Should(HavePrefix("usage: tween"))
+		out.Reset()
+		Ω(run([]string{"sample", "-h"}, out)).Should(Equal(flag.ErrHelp))
+		Ω(out.String()).Should(ContainSubstring("number of samples"))
+	})
+})
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/gopackage/tween/plot"
+)
+
+// point is a single sampled value of a curve.
+type point struct {
+	Completed    float64 `json:"completed"`
+	Transitioned float64 `json:"transitioned"`
+}
+
+// samples holds the sampled values of a curve.
+type samples struct {
+	Name    string  `json:"name"`
+	Samples []point `json:"samples"`
+}
+
+// sample writes n evenly spaced values of each curve as CSV (one column per
+// curve) or JSON.
+func sample(out io.Writer, series []plot.Series, n int, format string) error {
+	if n < 2 {
+		return fmt.Errorf("need at least 2 samples, got %d", n)
+	}
+	all := make([]samples, len(series))
+	for i, s := range series {
+		all[i].Name = s.Name
+		for j := 0; j < n; j++ {
+			completed := float64(j) / float64(n-1)
+			all[i].Samples = append(all[i].Samples, point{completed, s.Func(completed)})
+		}
+	}
+	switch format {
+	case "json":
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(all)
+	case "csv":
+		w := csv.NewWriter(out)
+		header := []string{"completed"}
+		for _, s := range all {
+			header = append(header, s.Name)
+		}
+		w.Write(header)
+		for j := 0; j < n; j++ {
+			row := []string{strconv.FormatFloat(all[0].Samples[j].Completed, 'g', -1, 64)}
+			for _, s := range all {
+				row = append(row, strconv.FormatFloat(s.Samples[j].Transitioned, 'g', -1, 64))
+			}
+			w.Write(row)
+		}
+		w.Flush()
+		return w.Error()
+	}
+	return fmt.Errorf("unknown sample format %q", format)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/gopackage/tween/plot"
+)
+
+// terminalPlot draws the curves as characters in a width x height grid. The
+// vertical range always includes 0 and 1 and grows to fit any overshoot.
+func terminalPlot(out io.Writer, series []plot.Series, width, height int, ascii bool) error {
+	if width < 2 || height < 2 {
+		return fmt.Errorf("plot needs to be at least 2x2, got %dx%d", width, height)
+	}
+	marks, vertical, horizontal, corner := []rune("●○◆◇■□"), '│', '─', '└'
+	if ascii {
+		marks, vertical, horizontal, corner = []rune("*o#x+@"), '|', '-', '+'
+	}
+	min, max := 0., 1.
+	for _, s := range series {
+		for col := 0; col < width; col++ {
+			y := s.Func(float64(col) / float64(width-1))
+			if !math.IsNaN(y) && !math.IsInf(y, 0) {
+				min, max = math.Min(min, y), math.Max(max, y)
+			}
+		}
+	}
+	row := func(y float64) int {
+		return int(math.Round((max - y) / (max - min) * float64(height-1)))
+	}
+
+	grid := make([][]rune, height)
+	for r := range grid {
+		grid[r] = make([]rune, width)
+		for c := range grid[r] {
+			grid[r][c] = ' '
+		}
+	}
+	for i, s := range series {
+		for col := 0; col < width; col++ {
+			y := s.Func(float64(col) / float64(width-1))
+			if r := row(y); r >= 0 && r < height {
+				grid[r][col] = marks[i%len(marks)]
+			}
+		}
+	}
+
+	w := bufio.NewWriter(out)
+	for i, s := range series {
+		fmt.Fprintf(w, "%c %s\n", marks[i%len(marks)], s.Name)
+	}
+	one, zero := row(1), row(0)
+	for r, line := range grid {
+		label := "      "
+		switch r {
+		case one:
+			label = "1.00 "
+		case zero:
+			label = "0.00 "
+		case 0:
+			label = fmt.Sprintf("%.2f ", max)
+		case height - 1:
+			label = fmt.Sprintf("%.2f ", min)
+		}
+		fmt.Fprintf(w, "%6s%c%s\n", label, vertical, string(line))
+	}
+	fmt.Fprintf(w, "%6s%c", "", corner)
+	for c := 0; c < width; c++ {
+		w.WriteRune(horizontal)
+	}
+	fmt.Fprintf(w, "\n%7s0%*s\n", "", width-1, "1")
+	return w.Flush()
+}
@@ -0,0 +1,116 @@
+package curves
+
+import (
+	"math"
+
+	"github.com/gopackage/tween"
+)
+
+// Bake precomputes a lookup table of sampled values of the transition and
+// returns a transition that linearly interpolates the table. Baking trades a
+// small amount of accuracy for a constant, cheap cost per frame which helps
+// when running thousands of tweens with expensive curves such as
+// EaseInBounce or EaseInElastic. Use MaxError to measure the accuracy.
+//
+// The table always includes the exact values at 0 and 1 and at least two
+// samples are taken. Values outside 0.0 - 1.0 return the end values and NaN
+// returns NaN.
+func Bake(fn tween.TransitionFunc, samples int) tween.TransitionFunc {
+	t := newTable(fn, samples)
+	return t.linear
+}
+
+// BakeCubic is like Bake but interpolates the table with a Catmull-Rom
+// spline, which is more accurate for smooth curves at a slightly higher cost.
+func BakeCubic(fn tween.TransitionFunc, samples int) tween.TransitionFunc {
+	t := newTable(fn, samples)
+	last := len(t.y) - 1
+	t.m = make([]float64, len(t.y))
+	for i := range t.m {
+		lo, hi := i-1, i+1
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > last {
+			hi = last
+		}
+		t.m[i] = (t.y[hi] - t.y[lo]) / (float64(hi-lo) * t.step)
+	}
+	return t.cubic
+}
+
+// MaxError measures the largest difference between a transition and an
+// approximation of it (e.g. a baked transition) at probes evenly spaced
+// points, returning the error and the completed value where it occurred.
+func MaxError(fn, approx tween.TransitionFunc, probes int) (err, at float64) {
+	if probes < 2 {
+		probes = 2
+	}
+	for i := 0; i < probes; i++ {
+		x := float64(i) / float64(probes-1)
+		if e := math.Abs(fn(x) - approx(x)); e > err {
+			err, at = e, x
+		}
+	}
+	return err, at
+}
+
+// table is an evenly spaced lookup table of transition values.
+type table struct {
+	y    []float64 // y is the transition value at each sample
+	m    []float64 // m is the tangent at each sample (cubic only)
+	step float64   // step is the completed distance between samples
+}
+
+func newTable(fn tween.TransitionFunc, samples int) *table {
+	if samples < 2 {
+		samples = 2
+	}
+	t := &table{
+		y:    make([]float64, samples),
+		step: 1 / float64(samples-1),
+	}
+	for i := range t.y {
+		t.y[i] = fn(float64(i) / float64(samples-1))
+	}
+	return t
+}
+
+// index locates the sample before completed and returns it together with
+// the fractional distance to the next sample. ok is false when completed is
+// outside the table and v holds the end value.
+func (t *table) index(completed float64) (i int, frac, v float64, ok bool) {
+	last := len(t.y) - 1
+	if math.IsNaN(completed) {
+		return 0, 0, completed, false
+	}
+	if completed <= 0 {
+		return 0, 0, t.y[0], false
+	}
+	if completed >= 1 {
+		return last, 0, t.y[last], false
+	}
+	pos := completed * float64(last)
+	i = int(pos)
+	if i >= last {
+		// completed just below 1 can round up to the last sample.
+		i = last - 1
+	}
+	return i, pos - float64(i), 0, true
+}
+
+func (t *table) linear(completed float64) float64 {
+	i, frac, v, ok := t.index(completed)
+	if !ok {
+		return v
+	}
+	return t.y[i] + (t.y[i+1]-t.y[i])*frac
+}
+
+func (t *table) cubic(completed float64) float64 {
+	i, frac, v, ok := t.index(completed)
+	if !ok {
+		return v
+	}
+	return hermiteAt(frac, 0, 1, t.y[i], t.y[i+1], t.m[i]*t.step, t.m[i+1]*t.step)
+}
@@ -0,0 +1,87 @@
+package curves_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bake", func() {
+	It("should keep exact end values", func() {
+		for _, curve := range funcs {
+			Ω(Bake(curve.Func, 16)(0)).Should(Equal(curve.Func(0)), curve.Name)
+			Ω(Bake(curve.Func, 16)(1)).Should(Equal(curve.Func(1)), curve.Name)
+			Ω(BakeCubic(curve.Func, 16)(1)).Should(Equal(curve.Func(1)), curve.Name)
+		}
+	})
+	It("should reproduce sampled values exactly", func() {
+		fn := Bake(EaseInOutSine, 11)
+		Ω(fn(.3)).Should(BeNumerically("~", EaseInOutSine(.3), 1e-12))
+	})
+	It("should approximate every curve closely", func() {
+		const samples = 1024
+		step := 1.0 / (samples - 1)
+		// Smooth curves stay well within one step (about 1e-3 at this size).
+		// Elastic and EaseInOutBounce jump between neighbouring samples so
+		// their error grows with the step, and Circ has a vertical tangent at
+		// its ends so its error only shrinks with the square root of the step.
+		tolerance := map[string]float64{
+			"EaseInCirc":       math.Sqrt(step) / 2,
+			"EaseOutCirc":      math.Sqrt(step) / 2,
+			"EaseInOutCirc":    math.Sqrt(step) / 2,
+			"EaseInElastic":    2 * step,
+			"EaseOutElastic":   2 * step,
+			"EaseInOutElastic": 2 * step,
+			"EaseInOutBounce":  2 * step,
+		}
+		for _, curve := range funcs {
+			limit, ok := tolerance[curve.Name]
+			if !ok {
+				limit = step
+			}
+			linear, at := MaxError(curve.Func, Bake(curve.Func, samples), 10000)
+			fmt.Fprintf(GinkgoWriter, "%-18s linear %.2e at %.4f", curve.Name, linear, at)
+			cubic, at := MaxError(curve.Func, BakeCubic(curve.Func, samples), 10000)
+			fmt.Fprintf(GinkgoWriter, "  cubic %.2e at %.4f\n", cubic, at)
+			Ω(linear).Should(BeNumerically("<", limit), curve.Name)
+			Ω(cubic).Should(BeNumerically("<", limit), curve.Name)
+		}
+	})
+	It("should return NaN for NaN input", func() {
+		Ω(math.IsNaN(Bake(EaseInOutSine, 16)(math.NaN()))).Should(BeTrue())
+		Ω(math.IsNaN(BakeCubic(EaseInOutSine, 16)(math.NaN()))).Should(BeTrue())
+	})
+	It("should be more accurate with cubic interpolation for smooth curves", func() {
+		linear, _ := MaxError(EaseInOutSine, Bake(EaseInOutSine, 64), 1000)
+		cubic, _ := MaxError(EaseInOutSine, BakeCubic(EaseInOutSine, 64), 1000)
+		Ω(cubic).Should(BeNumerically("<", linear))
+	})
+})
+
+func benchmarkCurves(b *testing.B, bake func(FuncInfo) func(float64) float64) {
+	for _, curve := range funcs {
+		fn := bake(curve)
+		b.Run(curve.Name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fn(float64(i%1000) / 1000)
+			}
+		})
+	}
+}
+
+func BenchmarkAnalytic(b *testing.B) {
+	benchmarkCurves(b, func(curve FuncInfo) func(float64) float64 { return curve.Func })
+}
+
+func BenchmarkBaked(b *testing.B) {
+	benchmarkCurves(b, func(curve FuncInfo) func(float64) float64 { return Bake(curve.Func, 1024) })
+}
+
+func BenchmarkBakedCubic(b *testing.B) {
+	benchmarkCurves(b, func(curve FuncInfo) func(float64) float64 { return BakeCubic(curve.Func, 1024) })
+}
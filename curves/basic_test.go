@@ -17,6 +17,44 @@ type FuncInfo struct {
 	Func tween.TransitionFunc
 }
 
+// funcs lists every predefined curve in the package.
+var funcs = []FuncInfo{
+	{"Linear", Linear},
+	{"Swing", Swing},
+	{"Smoothstep", Smoothstep},
+	{"Smootherstep", Smootherstep},
+	{"EaseInQuad", EaseInQuad},
+	{"EaseOutQuad", EaseOutQuad},
+	{"EaseInOutQuad", EaseInOutQuad},
+	{"EaseInCubic", EaseInCubic},
+	{"EaseOutCubic", EaseOutCubic},
+	{"EaseInOutCubic", EaseInOutCubic},
+	{"EaseInQuart", EaseInQuart},
+	{"EaseOutQuart", EaseOutQuart},
+	{"EaseInOutQuart", EaseInOutQuart},
+	{"EaseInQuint", EaseInQuint},
+	{"EaseOutQuint", EaseOutQuint},
+	{"EaseInOutQuint", EaseInOutQuint},
+	{"EaseInExpo", EaseInExpo},
+	{"EaseOutExpo", EaseOutExpo},
+	{"EaseInOutExpo", EaseInOutExpo},
+	{"EaseInSine", EaseInSine},
+	{"EaseOutSine", EaseOutSine},
+	{"EaseInOutSine", EaseInOutSine},
+	{"EaseInCirc", EaseInCirc},
+	{"EaseOutCirc", EaseOutCirc},
+	{"EaseInOutCirc", EaseInOutCirc},
+	{"EaseInElastic", EaseInElastic},
+	{"EaseOutElastic", EaseOutElastic},
+	{"EaseInOutElastic", EaseInOutElastic},
+	{"EaseInBack", EaseInBack},
+	{"EaseOutBack", EaseOutBack},
+	{"EaseInOutBack", EaseInOutBack},
+	{"EaseInBounce", EaseInBounce},
+	{"EaseOutBounce", EaseOutBounce},
+	{"EaseInOutBounce", EaseInOutBounce},
+}
+
 var _ = Describe("Basic Curves", func() {
 	Describe("Linear", func() {
 		It("should generate a linear curve", func() {
@@ -50,40 +88,6 @@ var _ = Describe("Basic Curves", func() {
 	})
 	Describe("Ease", func() {
 		It("should generate more advanced easing curves", func() {
-			funcs := []FuncInfo{
-				FuncInfo{"Linear", Linear},
-				FuncInfo{"Swing", Swing},
-				FuncInfo{"EaseInQuad", EaseInQuad},
-				FuncInfo{"EaseOutQuad", EaseOutQuad},
-				FuncInfo{"EaseInOutQuad", EaseInOutQuad},
-				FuncInfo{"EaseInCubic", EaseInCubic},
-				FuncInfo{"EaseOutCubic", EaseOutCubic},
-				FuncInfo{"EaseInOutCubic", EaseInOutCubic},
-				FuncInfo{"EaseInQuart", EaseInQuart},
-				FuncInfo{"EaseOutQuart", EaseOutQuart},
-				FuncInfo{"EaseInOutQuart", EaseInOutQuart},
-				FuncInfo{"EaseInQuint", EaseInQuint},
-				FuncInfo{"EaseOutQuint", EaseOutQuint},
-				FuncInfo{"EaseInOutQuint", EaseInOutQuint},
-				FuncInfo{"EaseInExpo", EaseInExpo},
-				FuncInfo{"EaseOutExpo", EaseOutExpo},
-				FuncInfo{"EaseInOutExpo", EaseInOutExpo},
-				FuncInfo{"EaseInSine", EaseInSine},
-				FuncInfo{"EaseOutSine", EaseOutSine},
-				FuncInfo{"EaseInOutSine", EaseInOutSine},
-				FuncInfo{"EaseInCirc", EaseInCirc},
-				FuncInfo{"EaseOutCirc", EaseOutCirc},
-				FuncInfo{"EaseInOutCirc", EaseInOutCirc},
-				FuncInfo{"EaseInElastic", EaseInElastic},
-				FuncInfo{"EaseOutElastic", EaseOutElastic},
-				FuncInfo{"EaseInOutElastic", EaseInOutElastic},
-				FuncInfo{"EaseInBack", EaseInBack},
-				FuncInfo{"EaseOutBack", EaseOutBack},
-				FuncInfo{"EaseInOutBack", EaseInOutBack},
-				FuncInfo{"EaseInBounce", EaseInBounce},
-				FuncInfo{"EaseOutBounce", EaseOutBounce},
-				FuncInfo{"EaseInOutBounce", EaseInOutBounce},
-			}
 			html, err := os.Create("curves.html")
 			Ω(err).Should(BeNil())
 			defer html.Close()
@@ -0,0 +1,128 @@
+package curves
+
+import (
+	"errors"
+	"math"
+
+	"github.com/gopackage/tween"
+)
+
+// ErrSegments is returned by Chain when the segments are empty, out of order
+// or do not end at 1.
+var ErrSegments = errors.New("curves: segments must be in increasing order and end at 1")
+
+// Segment is a portion of a chained transition. The segment runs from the
+// end of the previous segment (or 0) until Until, and Func provides the
+// curve for the segment.
+type Segment struct {
+	Until float64              // Until is the completed percentage where the segment ends.
+	Func  tween.TransitionFunc // Func is the transition used within the segment.
+}
+
+// Reverse plays a transition backwards, starting at the end value and
+// finishing at the start value.
+func Reverse(fn tween.TransitionFunc) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		return fn(1 - completed)
+	}
+}
+
+// Out converts an "ease in" transition into the matching "ease out"
+// transition by rotating the curve around the center point.
+func Out(in tween.TransitionFunc) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		return 1 - in(1-completed)
+	}
+}
+
+// InOut converts an "ease in" transition into an "ease in and out" transition
+// using the same construction as the generated EaseInOut* curves.
+func InOut(in tween.TransitionFunc) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		if completed < 0.5 {
+			return in(completed*2) / 2
+		}
+		return 1 - in((completed*-2)+2)/2
+	}
+}
+
+// Mirror creates a "yoyo" transition that runs the transition forward during
+// the first half and backward during the second half, ending where it started.
+func Mirror(fn tween.TransitionFunc) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		if completed < 0.5 {
+			return fn(completed * 2)
+		}
+		return fn(2 - completed*2)
+	}
+}
+
+// Blend mixes two transitions together. A weight of 0 returns a, a weight of
+// 1 returns b and 0.3 produces a 70/30 blend of a and b.
+func Blend(a, b tween.TransitionFunc, weight float64) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		return a(completed)*(1-weight) + b(completed)*weight
+	}
+}
+
+// Clamp limits the transition to the range 0.0 - 1.0, removing any overshoot
+// from curves such as EaseOutBack or EaseOutElastic.
+func Clamp(fn tween.TransitionFunc) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		return math.Max(0, math.Min(1, fn(completed)))
+	}
+}
+
+// Remap scales the transition so that it runs from min to max rather than
+// from 0 to 1.
+func Remap(fn tween.TransitionFunc, min, max float64) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		return min + (max-min)*fn(completed)
+	}
+}
+
+// Compose combines transitions so that each transition receives the result
+// of the one after it: Compose(f, g)(x) is f(g(x)). Compose with no
+// transitions is Linear.
+func Compose(fns ...tween.TransitionFunc) tween.TransitionFunc {
+	return func(completed float64) float64 {
+		for i := len(fns) - 1; i >= 0; i-- {
+			completed = fns[i](completed)
+		}
+		return completed
+	}
+}
+
+// Chain concatenates transitions into a single transition. Each segment
+// covers the completed range from the previous segment's Until to its own,
+// and transitions over the same range of values, so chaining EaseInQuad until
+// 0.5 with EaseOutQuad until 1 is equivalent to EaseInOutQuad. A nil Func is
+// treated as Linear.
+func Chain(segments ...Segment) (tween.TransitionFunc, error) {
+	if len(segments) == 0 || segments[len(segments)-1].Until != 1 {
+		return nil, ErrSegments
+	}
+	segs := make([]Segment, len(segments))
+	start := 0.
+	for i, s := range segments {
+		if s.Until <= start {
+			return nil, ErrSegments
+		}
+		if s.Func == nil {
+			s.Func = Linear
+		}
+		segs[i] = s
+		start = s.Until
+	}
+	return func(completed float64) float64 {
+		start := 0.
+		for _, s := range segs {
+			if completed < s.Until || s.Until == 1 {
+				span := s.Until - start
+				return start + span*s.Func((completed-start)/span)
+			}
+			start = s.Until
+		}
+		return completed
+	}, nil
+}
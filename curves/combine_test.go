@@ -0,0 +1,100 @@
+package curves_test
+
+import (
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Combinators", func() {
+	Describe("Reverse", func() {
+		It("should play the transition backwards", func() {
+			fn := Reverse(EaseInQuad)
+			Ω(fn(0)).Should(Equal(1.))
+			Ω(fn(.25)).Should(Equal(EaseInQuad(.75)))
+			Ω(fn(1)).Should(Equal(0.))
+		})
+	})
+	Describe("Out", func() {
+		It("should match the generated ease out curve", func() {
+			fn := Out(EaseInCubic)
+			for x := 0.; x <= 1; x += .1 {
+				Ω(fn(x)).Should(BeNumerically("~", EaseOutCubic(x), 1e-12))
+			}
+		})
+	})
+	Describe("InOut", func() {
+		It("should match the generated ease in out curve", func() {
+			fn := InOut(EaseInBounce)
+			for x := 0.; x <= 1; x += .1 {
+				Ω(fn(x)).Should(BeNumerically("~", EaseInOutBounce(x), 1e-12))
+			}
+		})
+	})
+	Describe("Mirror", func() {
+		It("should go there and back again", func() {
+			fn := Mirror(Linear)
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(.25)).Should(Equal(.5))
+			Ω(fn(.5)).Should(Equal(1.))
+			Ω(fn(.75)).Should(Equal(.5))
+			Ω(fn(1)).Should(Equal(0.))
+		})
+	})
+	Describe("Blend", func() {
+		It("should mix two transitions by weight", func() {
+			fn := Blend(Linear, EaseInQuad, .3)
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(.5)).Should(BeNumerically("~", .7*.5+.3*.25, 1e-12))
+			Ω(fn(1)).Should(Equal(1.))
+		})
+	})
+	Describe("Clamp", func() {
+		It("should remove overshoot", func() {
+			fn := Clamp(EaseInBack)
+			Ω(EaseInBack(.2)).Should(BeNumerically("<", 0))
+			Ω(fn(.2)).Should(Equal(0.))
+			Ω(fn(.9)).Should(Equal(EaseInBack(.9)))
+		})
+	})
+	Describe("Remap", func() {
+		It("should scale the output range", func() {
+			fn := Remap(Linear, .2, .6)
+			Ω(fn(0)).Should(Equal(.2))
+			Ω(fn(.5)).Should(BeNumerically("~", .4, 1e-12))
+			Ω(fn(1)).Should(Equal(.6))
+		})
+	})
+	Describe("Compose", func() {
+		It("should apply the last transition first", func() {
+			fn := Compose(Remap(Linear, 0, 2), EaseInQuad)
+			Ω(fn(.5)).Should(Equal(.5))
+			Ω(Compose()(.3)).Should(Equal(.3))
+		})
+	})
+	Describe("Chain", func() {
+		It("should concatenate transitions", func() {
+			fn, err := Chain(Segment{.5, EaseInQuad}, Segment{1, EaseOutQuad})
+			Ω(err).Should(BeNil())
+			for x := 0.; x <= 1; x += .05 {
+				Ω(fn(x)).Should(BeNumerically("~", EaseInOutQuad(x), 1e-12))
+			}
+			Ω(fn(1)).Should(Equal(1.))
+		})
+		It("should treat missing transitions as linear", func() {
+			fn, err := Chain(Segment{.25, nil}, Segment{1, nil})
+			Ω(err).Should(BeNil())
+			Ω(fn(.1)).Should(BeNumerically("~", .1, 1e-12))
+			Ω(fn(.6)).Should(BeNumerically("~", .6, 1e-12))
+		})
+		It("should reject invalid segments", func() {
+			_, err := Chain()
+			Ω(err).Should(Equal(ErrSegments))
+			_, err = Chain(Segment{.5, Linear})
+			Ω(err).Should(Equal(ErrSegments))
+			_, err = Chain(Segment{.5, Linear}, Segment{.5, Linear}, Segment{1, Linear})
+			Ω(err).Should(Equal(ErrSegments))
+		})
+	})
+})
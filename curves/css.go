@@ -0,0 +1,105 @@
+package curves
+
+import (
+	"math"
+
+	"github.com/gopackage/tween"
+)
+
+// Jump selects where the jumps of a Steps transition occur, matching the
+// CSS steps() jump terms.
+type Jump int
+
+const (
+	// JumpEnd holds each step until the end of its interval (CSS jump-end).
+	JumpEnd Jump = iota
+	// JumpStart jumps at the start of each interval (CSS jump-start).
+	JumpStart
+	// JumpNone holds both the start and end values for a full step (CSS jump-none).
+	JumpNone
+	// JumpBoth jumps at both the start and the end (CSS jump-both).
+	JumpBoth
+)
+
+// Predefined CSS timing functions.
+var (
+	Ease      = CubicBezier(.25, .1, .25, 1) // Ease is the CSS ease timing function.
+	EaseIn    = CubicBezier(.42, 0, 1, 1)    // EaseIn is the CSS ease-in timing function.
+	EaseOut   = CubicBezier(0, 0, .58, 1)    // EaseOut is the CSS ease-out timing function.
+	EaseInOut = CubicBezier(.42, 0, .58, 1)  // EaseInOut is the CSS ease-in-out timing function.
+	StepStart = Steps(1, JumpStart)          // StepStart is the CSS step-start timing function.
+	StepEnd   = Steps(1, JumpEnd)            // StepEnd is the CSS step-end timing function.
+)
+
+// CubicBezier creates a transition from a cubic Bézier curve starting at
+// (0, 0) and ending at (1, 1) with control points (x1, y1) and (x2, y2),
+// matching the CSS cubic-bezier() timing function. x1 and x2 are clamped to
+// 0.0 - 1.0 so the curve is a function of time.
+func CubicBezier(x1, y1, x2, y2 float64) tween.TransitionFunc {
+	x1 = math.Max(0, math.Min(1, x1))
+	x2 = math.Max(0, math.Min(1, x2))
+	// Polynomial coefficients of each axis: ((a*t + b)*t + c)*t
+	cx := 3 * x1
+	bx := 3*(x2-x1) - cx
+	ax := 1 - cx - bx
+	cy := 3 * y1
+	by := 3*(y2-y1) - cy
+	ay := 1 - cy - by
+	return func(completed float64) float64 {
+		if completed <= 0 || completed >= 1 {
+			return completed
+		}
+		// Solve x(t) = completed with Newton's method, falling back to
+		// bisection when the slope is too flat to converge.
+		t := completed
+		for i := 0; i < 8; i++ {
+			x := ((ax*t+bx)*t+cx)*t - completed
+			if math.Abs(x) < 1e-9 {
+				return ((ay*t+by)*t + cy) * t
+			}
+			d := (3*ax*t+2*bx)*t + cx
+			if math.Abs(d) < 1e-6 {
+				break
+			}
+			t -= x / d
+		}
+		lo, hi := 0., 1.
+		t = completed
+		for hi-lo > 1e-9 {
+			x := ((ax*t+bx)*t + cx) * t
+			if x < completed {
+				lo = t
+			} else {
+				hi = t
+			}
+			t = (lo + hi) / 2
+		}
+		return ((ay*t+by)*t + cy) * t
+	}
+}
+
+// Steps creates a transition that moves in n equal jumps rather than
+// smoothly, matching the CSS steps() timing function. n is raised to the
+// minimum number of steps for the jump (1, or 2 for JumpNone).
+func Steps(n int, jump Jump) tween.TransitionFunc {
+	if n < 1 {
+		n = 1
+	}
+	if jump == JumpNone && n < 2 {
+		n = 2
+	}
+	jumps := float64(n)
+	switch jump {
+	case JumpNone:
+		jumps--
+	case JumpBoth:
+		jumps++
+	}
+	return func(completed float64) float64 {
+		step := math.Floor(completed * float64(n))
+		if jump == JumpStart || jump == JumpBoth {
+			step++
+		}
+		return math.Max(0, math.Min(jumps, step)) / jumps
+	}
+}
@@ -0,0 +1,59 @@
+package curves_test
+
+import (
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CSS Curves", func() {
+	Describe("CubicBezier", func() {
+		It("should be linear with control points on the diagonal", func() {
+			fn := CubicBezier(.25, .25, .75, .75)
+			for x := 0.; x <= 1; x += .1 {
+				Ω(fn(x)).Should(BeNumerically("~", x, 1e-6))
+			}
+		})
+		It("should match known CSS values", func() {
+			// Values from the WebKit UnitBezier implementation
+			Ω(Ease(.5)).Should(BeNumerically("~", .8024, 1e-4))
+			Ω(EaseIn(.5)).Should(BeNumerically("~", .3153, 1e-4))
+			Ω(EaseOut(.5)).Should(BeNumerically("~", .6847, 1e-4))
+			Ω(EaseInOut(.5)).Should(BeNumerically("~", .5, 1e-6))
+			Ω(EaseInOut(0)).Should(Equal(0.))
+			Ω(EaseInOut(1)).Should(Equal(1.))
+		})
+		It("should solve flat curves", func() {
+			fn := CubicBezier(1, 0, 0, 1)
+			Ω(fn(.5)).Should(BeNumerically("~", .5, 1e-6))
+			Ω(fn(.49)).Should(BeNumerically("<", .5))
+		})
+	})
+	Describe("Steps", func() {
+		It("should jump at the end of each step", func() {
+			fn := Steps(4, JumpEnd)
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(.24)).Should(Equal(0.))
+			Ω(fn(.25)).Should(Equal(.25))
+			Ω(fn(.99)).Should(Equal(.75))
+			Ω(fn(1)).Should(Equal(1.))
+		})
+		It("should jump at the start of each step", func() {
+			fn := Steps(4, JumpStart)
+			Ω(fn(0)).Should(Equal(.25))
+			Ω(fn(.8)).Should(Equal(1.))
+			Ω(fn(1)).Should(Equal(1.))
+		})
+		It("should support jump-none and jump-both", func() {
+			none := Steps(3, JumpNone)
+			Ω(none(0)).Should(Equal(0.))
+			Ω(none(.5)).Should(Equal(.5))
+			Ω(none(.9)).Should(Equal(1.))
+			both := Steps(3, JumpBoth)
+			Ω(both(0)).Should(Equal(.25))
+			Ω(both(.5)).Should(Equal(.5))
+			Ω(both(1)).Should(Equal(1.))
+		})
+	})
+})
@@ -4,6 +4,8 @@ import (
 	"math"
 )
 
+// Auto-generated file - do not edit directly! See source in curves/gen/gen.go
+
 // EaseInQuad eases in a Quad transition.
 // See http://jqueryui.com/easing/ for curve in action.
 func EaseInQuad(completed float64) float64 {
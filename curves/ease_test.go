@@ -0,0 +1,205 @@
+// Auto-generated file - do not edit directly! See source in curves/gen/gen.go
+
+package curves_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gopackage/tween"
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// easeFuncs maps the name of each generated ease function to the function.
+var easeFuncs = map[string]tween.TransitionFunc{
+	"EaseInQuad":       EaseInQuad,
+	"EaseOutQuad":      EaseOutQuad,
+	"EaseInOutQuad":    EaseInOutQuad,
+	"EaseInCubic":      EaseInCubic,
+	"EaseOutCubic":     EaseOutCubic,
+	"EaseInOutCubic":   EaseInOutCubic,
+	"EaseInQuart":      EaseInQuart,
+	"EaseOutQuart":     EaseOutQuart,
+	"EaseInOutQuart":   EaseInOutQuart,
+	"EaseInQuint":      EaseInQuint,
+	"EaseOutQuint":     EaseOutQuint,
+	"EaseInOutQuint":   EaseInOutQuint,
+	"EaseInExpo":       EaseInExpo,
+	"EaseOutExpo":      EaseOutExpo,
+	"EaseInOutExpo":    EaseInOutExpo,
+	"EaseInSine":       EaseInSine,
+	"EaseOutSine":      EaseOutSine,
+	"EaseInOutSine":    EaseInOutSine,
+	"EaseInCirc":       EaseInCirc,
+	"EaseOutCirc":      EaseOutCirc,
+	"EaseInOutCirc":    EaseInOutCirc,
+	"EaseInElastic":    EaseInElastic,
+	"EaseOutElastic":   EaseOutElastic,
+	"EaseInOutElastic": EaseInOutElastic,
+	"EaseInBack":       EaseInBack,
+	"EaseOutBack":      EaseOutBack,
+	"EaseInOutBack":    EaseInOutBack,
+	"EaseInBounce":     EaseInBounce,
+	"EaseOutBounce":    EaseOutBounce,
+	"EaseInOutBounce":  EaseInOutBounce,
+}
+
+var _ = Describe("Ease Curves", func() {
+	DescribeTable("should start at 0 and end at 1",
+		func(fn tween.TransitionFunc) {
+			Ω(fn(0)).Should(BeNumerically("~", 0, 1e-12))
+			Ω(fn(1)).Should(BeNumerically("~", 1, 1e-12))
+		},
+		Entry("EaseInQuad", EaseInQuad),
+		Entry("EaseOutQuad", EaseOutQuad),
+		Entry("EaseInOutQuad", EaseInOutQuad),
+		Entry("EaseInCubic", EaseInCubic),
+		Entry("EaseOutCubic", EaseOutCubic),
+		Entry("EaseInOutCubic", EaseInOutCubic),
+		Entry("EaseInQuart", EaseInQuart),
+		Entry("EaseOutQuart", EaseOutQuart),
+		Entry("EaseInOutQuart", EaseInOutQuart),
+		Entry("EaseInQuint", EaseInQuint),
+		Entry("EaseOutQuint", EaseOutQuint),
+		Entry("EaseInOutQuint", EaseInOutQuint),
+		Entry("EaseInExpo", EaseInExpo),
+		Entry("EaseOutExpo", EaseOutExpo),
+		Entry("EaseInOutExpo", EaseInOutExpo),
+		Entry("EaseInSine", EaseInSine),
+		Entry("EaseOutSine", EaseOutSine),
+		Entry("EaseInOutSine", EaseInOutSine),
+		Entry("EaseInCirc", EaseInCirc),
+		Entry("EaseOutCirc", EaseOutCirc),
+		Entry("EaseInOutCirc", EaseInOutCirc),
+		Entry("EaseInElastic", EaseInElastic),
+		Entry("EaseOutElastic", EaseOutElastic),
+		Entry("EaseInOutElastic", EaseInOutElastic),
+		Entry("EaseInBack", EaseInBack),
+		Entry("EaseOutBack", EaseOutBack),
+		Entry("EaseInOutBack", EaseInOutBack),
+		Entry("EaseInBounce", EaseInBounce),
+		Entry("EaseOutBounce", EaseOutBounce),
+		Entry("EaseInOutBounce", EaseInOutBounce),
+	)
+	DescribeTable("should ease out by rotating ease in",
+		func(in, out tween.TransitionFunc) {
+			for i := 0; i <= 20; i++ {
+				x := float64(i) / 20
+				Ω(out(x)).Should(BeNumerically("~", 1-in(1-x), 1e-12))
+			}
+		},
+		Entry("Quad", EaseInQuad, EaseOutQuad),
+		Entry("Cubic", EaseInCubic, EaseOutCubic),
+		Entry("Quart", EaseInQuart, EaseOutQuart),
+		Entry("Quint", EaseInQuint, EaseOutQuint),
+		Entry("Expo", EaseInExpo, EaseOutExpo),
+		Entry("Sine", EaseInSine, EaseOutSine),
+		Entry("Circ", EaseInCirc, EaseOutCirc),
+		Entry("Elastic", EaseInElastic, EaseOutElastic),
+		Entry("Back", EaseInBack, EaseOutBack),
+		Entry("Bounce", EaseInBounce, EaseOutBounce),
+	)
+	DescribeTable("should be symmetric when easing in and out",
+		func(fn tween.TransitionFunc) {
+			Ω(fn(.5)).Should(BeNumerically("~", .5, 1e-12))
+			for i := 0; i < 10; i++ {
+				x := float64(i) / 20
+				Ω(fn(x) + fn(1-x)).Should(BeNumerically("~", 1, 1e-12))
+			}
+		},
+		Entry("EaseInOutQuad", EaseInOutQuad),
+		Entry("EaseInOutCubic", EaseInOutCubic),
+		Entry("EaseInOutQuart", EaseInOutQuart),
+		Entry("EaseInOutQuint", EaseInOutQuint),
+		Entry("EaseInOutExpo", EaseInOutExpo),
+		Entry("EaseInOutSine", EaseInOutSine),
+		Entry("EaseInOutCirc", EaseInOutCirc),
+		Entry("EaseInOutElastic", EaseInOutElastic),
+		Entry("EaseInOutBack", EaseInOutBack),
+		Entry("EaseInOutBounce", EaseInOutBounce),
+	)
+	DescribeTable("should match known midpoints",
+		func(fn tween.TransitionFunc, half float64) {
+			Ω(fn(.5)).Should(BeNumerically("~", half, 1e-12))
+		},
+		Entry("EaseInQuad", EaseInQuad, 0.25),
+		Entry("EaseInCubic", EaseInCubic, 0.125),
+		Entry("EaseInQuart", EaseInQuart, 0.0625),
+		Entry("EaseInQuint", EaseInQuint, 0.03125),
+		Entry("EaseInExpo", EaseInExpo, 0.015625),
+		Entry("EaseInSine", EaseInSine, 0.2928932188134524),
+		Entry("EaseInCirc", EaseInCirc, 0.1339745962155614),
+		Entry("EaseInElastic", EaseInElastic, -0.03125000000000004),
+		Entry("EaseInBack", EaseInBack, -0.125),
+		Entry("EaseInBounce", EaseInBounce, 0.234375),
+	)
+	It("should match the reference golden vectors", func() {
+		data, err := ioutil.ReadFile("testdata/ease_golden.json")
+		Ω(err).Should(BeNil())
+		vectors := []struct {
+			Name   string    `json:"name"`
+			Values []float64 `json:"values"`
+		}{}
+		Ω(json.Unmarshal(data, &vectors)).Should(Succeed())
+		Ω(vectors).Should(HaveLen(len(easeFuncs)))
+		for _, v := range vectors {
+			fn, ok := easeFuncs[v.Name]
+			Ω(ok).Should(BeTrue(), v.Name)
+			for i, want := range v.Values {
+				x := float64(i) / float64(len(v.Values)-1)
+				Ω(fn(x)).Should(BeNumerically("~", want, 1e-12), "%s(%v)", v.Name, x)
+			}
+		}
+	})
+})
+
+func benchmarkEase(b *testing.B, fn tween.TransitionFunc) {
+	for i := 0; i < b.N; i++ {
+		fn(float64(i%1000) / 1000)
+	}
+}
+
+func BenchmarkEaseInQuad(b *testing.B)    { benchmarkEase(b, EaseInQuad) }
+func BenchmarkEaseOutQuad(b *testing.B)   { benchmarkEase(b, EaseOutQuad) }
+func BenchmarkEaseInOutQuad(b *testing.B) { benchmarkEase(b, EaseInOutQuad) }
+
+func BenchmarkEaseInCubic(b *testing.B)    { benchmarkEase(b, EaseInCubic) }
+func BenchmarkEaseOutCubic(b *testing.B)   { benchmarkEase(b, EaseOutCubic) }
+func BenchmarkEaseInOutCubic(b *testing.B) { benchmarkEase(b, EaseInOutCubic) }
+
+func BenchmarkEaseInQuart(b *testing.B)    { benchmarkEase(b, EaseInQuart) }
+func BenchmarkEaseOutQuart(b *testing.B)   { benchmarkEase(b, EaseOutQuart) }
+func BenchmarkEaseInOutQuart(b *testing.B) { benchmarkEase(b, EaseInOutQuart) }
+
+func BenchmarkEaseInQuint(b *testing.B)    { benchmarkEase(b, EaseInQuint) }
+func BenchmarkEaseOutQuint(b *testing.B)   { benchmarkEase(b, EaseOutQuint) }
+func BenchmarkEaseInOutQuint(b *testing.B) { benchmarkEase(b, EaseInOutQuint) }
+
+func BenchmarkEaseInExpo(b *testing.B)    { benchmarkEase(b, EaseInExpo) }
+func BenchmarkEaseOutExpo(b *testing.B)   { benchmarkEase(b, EaseOutExpo) }
+func BenchmarkEaseInOutExpo(b *testing.B) { benchmarkEase(b, EaseInOutExpo) }
+
+func BenchmarkEaseInSine(b *testing.B)    { benchmarkEase(b, EaseInSine) }
+func BenchmarkEaseOutSine(b *testing.B)   { benchmarkEase(b, EaseOutSine) }
+func BenchmarkEaseInOutSine(b *testing.B) { benchmarkEase(b, EaseInOutSine) }
+
+func BenchmarkEaseInCirc(b *testing.B)    { benchmarkEase(b, EaseInCirc) }
+func BenchmarkEaseOutCirc(b *testing.B)   { benchmarkEase(b, EaseOutCirc) }
+func BenchmarkEaseInOutCirc(b *testing.B) { benchmarkEase(b, EaseInOutCirc) }
+
+func BenchmarkEaseInElastic(b *testing.B)    { benchmarkEase(b, EaseInElastic) }
+func BenchmarkEaseOutElastic(b *testing.B)   { benchmarkEase(b, EaseOutElastic) }
+func BenchmarkEaseInOutElastic(b *testing.B) { benchmarkEase(b, EaseInOutElastic) }
+
+func BenchmarkEaseInBack(b *testing.B)    { benchmarkEase(b, EaseInBack) }
+func BenchmarkEaseOutBack(b *testing.B)   { benchmarkEase(b, EaseOutBack) }
+func BenchmarkEaseInOutBack(b *testing.B) { benchmarkEase(b, EaseInOutBack) }
+
+func BenchmarkEaseInBounce(b *testing.B)    { benchmarkEase(b, EaseInBounce) }
+func BenchmarkEaseOutBounce(b *testing.B)   { benchmarkEase(b, EaseOutBounce) }
+func BenchmarkEaseInOutBounce(b *testing.B) { benchmarkEase(b, EaseInOutBounce) }
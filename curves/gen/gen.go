@@ -2,18 +2,32 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"go/format"
 	"io/ioutil"
+	"math"
 	"os"
+	"path/filepath"
 	"text/template"
 )
 
 type info struct {
 	Name string
 	Func string
+	Half float64 // Half is the reference EaseIn value at 0.5
 }
 
+// golden is the reference values for one ease function.
+type golden struct {
+	Name   string    `json:"name"`
+	Values []float64 `json:"values"`
+}
+
+// goldenSteps is the number of intervals the golden vectors are sampled at.
+const goldenSteps = 20
+
 // Must will panic if there is an error. Use Must to wrap functions that
 // return an error that you know won't occur or is fatal if it does.
 func Must(err error) {
@@ -49,18 +63,200 @@ func EaseInOut{{.Name}}(completed float64) float64 {
 `,
 }
 
+var testHeader = `
+// Auto-generated file - do not edit directly! See source in curves/gen/gen.go
+
+package curves_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gopackage/tween"
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// easeFuncs maps the name of each generated ease function to the function.
+var easeFuncs = map[string]tween.TransitionFunc{
+{{- range .}}
+	"EaseIn{{.Name}}": EaseIn{{.Name}},
+	"EaseOut{{.Name}}": EaseOut{{.Name}},
+	"EaseInOut{{.Name}}": EaseInOut{{.Name}},
+{{- end}}
+}
+
+var _ = Describe("Ease Curves", func() {
+	DescribeTable("should start at 0 and end at 1",
+		func(fn tween.TransitionFunc) {
+			Ω(fn(0)).Should(BeNumerically("~", 0, 1e-12))
+			Ω(fn(1)).Should(BeNumerically("~", 1, 1e-12))
+		},
+{{- range .}}
+		Entry("EaseIn{{.Name}}", EaseIn{{.Name}}),
+		Entry("EaseOut{{.Name}}", EaseOut{{.Name}}),
+		Entry("EaseInOut{{.Name}}", EaseInOut{{.Name}}),
+{{- end}}
+	)
+	DescribeTable("should ease out by rotating ease in",
+		func(in, out tween.TransitionFunc) {
+			for i := 0; i <= 20; i++ {
+				x := float64(i) / 20
+				Ω(out(x)).Should(BeNumerically("~", 1-in(1-x), 1e-12))
+			}
+		},
+{{- range .}}
+		Entry("{{.Name}}", EaseIn{{.Name}}, EaseOut{{.Name}}),
+{{- end}}
+	)
+	DescribeTable("should be symmetric when easing in and out",
+		func(fn tween.TransitionFunc) {
+			Ω(fn(.5)).Should(BeNumerically("~", .5, 1e-12))
+			for i := 0; i < 10; i++ {
+				x := float64(i) / 20
+				Ω(fn(x) + fn(1-x)).Should(BeNumerically("~", 1, 1e-12))
+			}
+		},
+{{- range .}}
+		Entry("EaseInOut{{.Name}}", EaseInOut{{.Name}}),
+{{- end}}
+	)
+	DescribeTable("should match known midpoints",
+		func(fn tween.TransitionFunc, half float64) {
+			Ω(fn(.5)).Should(BeNumerically("~", half, 1e-12))
+		},
+{{- range .}}
+		Entry("EaseIn{{.Name}}", EaseIn{{.Name}}, {{printf "%v" .Half}}),
+{{- end}}
+	)
+	It("should match the reference golden vectors", func() {
+		data, err := ioutil.ReadFile("testdata/ease_golden.json")
+		Ω(err).Should(BeNil())
+		vectors := []struct {
+			Name   string    ` + "`json:\"name\"`" + `
+			Values []float64 ` + "`json:\"values\"`" + `
+		}{}
+		Ω(json.Unmarshal(data, &vectors)).Should(Succeed())
+		Ω(vectors).Should(HaveLen(len(easeFuncs)))
+		for _, v := range vectors {
+			fn, ok := easeFuncs[v.Name]
+			Ω(ok).Should(BeTrue(), v.Name)
+			for i, want := range v.Values {
+				x := float64(i) / float64(len(v.Values)-1)
+				Ω(fn(x)).Should(BeNumerically("~", want, 1e-12), "%s(%v)", v.Name, x)
+			}
+		}
+	})
+})
+
+func benchmarkEase(b *testing.B, fn tween.TransitionFunc) {
+	for i := 0; i < b.N; i++ {
+		fn(float64(i%1000) / 1000)
+	}
+}
+{{range .}}
+func BenchmarkEaseIn{{.Name}}(b *testing.B)    { benchmarkEase(b, EaseIn{{.Name}}) }
+func BenchmarkEaseOut{{.Name}}(b *testing.B)   { benchmarkEase(b, EaseOut{{.Name}}) }
+func BenchmarkEaseInOut{{.Name}}(b *testing.B) { benchmarkEase(b, EaseInOut{{.Name}}) }
+{{end}}
+`
+
+// reference is a direct translation of the jQuery UI easing functions (see
+// the bottom of this file) used to produce the golden vectors that the
+// generated functions are tested against.
+var reference = map[string]func(p float64) float64{
+	"Quad":  func(p float64) float64 { return math.Pow(p, 2) },
+	"Cubic": func(p float64) float64 { return math.Pow(p, 3) },
+	"Quart": func(p float64) float64 { return math.Pow(p, 4) },
+	"Quint": func(p float64) float64 { return math.Pow(p, 5) },
+	"Expo":  func(p float64) float64 { return math.Pow(p, 6) },
+	"Sine":  func(p float64) float64 { return 1 - math.Cos(p*math.Pi/2) },
+	"Circ":  func(p float64) float64 { return 1 - math.Sqrt(1-p*p) },
+	"Elastic": func(p float64) float64 {
+		if p == 0 || p == 1 {
+			return p
+		}
+		return -math.Pow(2, 8*(p-1)) * math.Sin(((p-1)*80-7.5)*math.Pi/15)
+	},
+	"Back": func(p float64) float64 { return p * p * (3*p - 2) },
+	"Bounce": func(p float64) float64 {
+		var pow2 float64
+		bounce := 4.
+		for {
+			bounce--
+			pow2 = math.Pow(2, bounce)
+			if p >= (pow2-1)/11 {
+				break
+			}
+		}
+		return 1/math.Pow(4, 3-bounce) - 7.5625*math.Pow((pow2*3-2)/22-p, 2)
+	},
+}
+
+// goldenVectors samples the reference easing functions.
+func goldenVectors() []golden {
+	vectors := []golden{}
+	for _, b := range base {
+		easeIn := reference[b.Name]
+		variants := []struct {
+			prefix string
+			fn     func(p float64) float64
+		}{
+			{"EaseIn", easeIn},
+			{"EaseOut", func(p float64) float64 { return 1 - easeIn(1-p) }},
+			{"EaseInOut", func(p float64) float64 {
+				if p < 0.5 {
+					return easeIn(p*2) / 2
+				}
+				return 1 - easeIn(p*-2+2)/2
+			}},
+		}
+		for _, v := range variants {
+			g := golden{Name: v.prefix + b.Name}
+			for i := 0; i <= goldenSteps; i++ {
+				g.Values = append(g.Values, v.fn(float64(i)/goldenSteps))
+			}
+			vectors = append(vectors, g)
+		}
+	}
+	return vectors
+}
+
+// write formats Go source (if the name ends in .go) and writes it to dir.
+func write(dir, name string, src []byte) {
+	if filepath.Ext(name) == ".go" {
+		frmt, err := format.Source(src)
+		if err != nil {
+			for index, line := range bytes.Split(src, []byte("\n")) {
+				fmt.Println(index+1, string(line))
+			}
+			panic(err)
+		}
+		src = frmt
+	}
+	path := filepath.Join(dir, name)
+	Must(os.MkdirAll(filepath.Dir(path), 0755))
+	Must(ioutil.WriteFile(path, src, 0644))
+}
+
 var base = []*info{}
 
 func add(name, f string) {
-	base = append(base, &info{name, f})
+	base = append(base, &info{Name: name, Func: f})
 }
 
 func main() {
+	dir := flag.String("dir", ".", "directory to write the generated files to")
+	flag.Parse()
 
 	// Basic polynomial curves
 	for i, name := range []string{"Quad", "Cubic", "Quart", "Quint", "Expo"} {
 		p := fmt.Sprintf("return math.Pow(completed, %d)", i+2)
-		inf := &info{name, p}
+		inf := &info{Name: name, Func: p}
 		base = append(base, inf)
 	}
 	// Sine curve
@@ -106,15 +302,19 @@ import ("math")
 		}
 	}
 
-	// go fmt
-	frmt, err := format.Source(out.Bytes())
-	if err != nil {
-		for index, line := range bytes.Split(out.Bytes(), []byte("\n")) {
-			fmt.Println(index+1, string(line))
-		}
-		panic(err)
+	write(*dir, "ease.go", out.Bytes())
+
+	// Generate tests and benchmarks, checked against the reference golden
+	// vectors
+	for _, b := range base {
+		b.Half = reference[b.Name](0.5)
 	}
-	Must(ioutil.WriteFile("ease.go", frmt, os.ModePerm))
+	out.Reset()
+	Must(template.Must(template.New("test").Parse(testHeader)).Execute(&out, base))
+	write(*dir, "ease_test.go", out.Bytes())
+	vectors, err := json.MarshalIndent(goldenVectors(), "", "  ")
+	Must(err)
+	write(*dir, filepath.Join("testdata", "ease_golden.json"), append(vectors, '\n'))
 }
 
 // Based on easing equations from Robert Penner (http://www.robertpenner.com/easing)
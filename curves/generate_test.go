@@ -0,0 +1,28 @@
+package curves_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Generator", func() {
+	It("should have generated the current ease functions and tests", func() {
+		dir, err := ioutil.TempDir("", "gen")
+		Ω(err).Should(BeNil())
+		defer os.RemoveAll(dir)
+		out, err := exec.Command("go", "run", "gen/gen.go", "-dir", dir).CombinedOutput()
+		Ω(err).Should(BeNil(), string(out))
+		for _, name := range []string{"ease.go", "ease_test.go", filepath.Join("testdata", "ease_golden.json")} {
+			generated, err := ioutil.ReadFile(filepath.Join(dir, name))
+			Ω(err).Should(BeNil())
+			current, err := ioutil.ReadFile(name)
+			Ω(err).Should(BeNil())
+			Ω(string(current)).Should(Equal(string(generated)), "%s is out of date - run go generate ./...", name)
+		}
+	})
+})
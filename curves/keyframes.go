@@ -0,0 +1,59 @@
+package curves
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/gopackage/tween"
+)
+
+// ErrKeyframes is returned by Keyframes when the keyframes do not start at 0
+// and end at 1 or two keyframes share the same time.
+var ErrKeyframes = errors.New("curves: keyframes must start at 0, end at 1 and have unique times")
+
+// Keyframe is a control point in a keyframe transition, similar to a CSS
+// @keyframes rule with its own animation-timing-function.
+type Keyframe struct {
+	Time   float64              // Time is the completed percentage 0.0 - 1.0 of the keyframe.
+	Value  float64              // Value is the transition value at the keyframe.
+	Easing tween.TransitionFunc // Easing is the transition from this keyframe to the next (nil is Linear).
+}
+
+// Keyframes creates a transition that passes through each keyframe value at
+// the keyframe time. Each segment between two keyframes uses the Easing of
+// the keyframe it starts from. Keyframes are sorted by time so they may be
+// provided in any order. NaN returns NaN.
+func Keyframes(keyframes ...Keyframe) (tween.TransitionFunc, error) {
+	frames := make([]Keyframe, len(keyframes))
+	copy(frames, keyframes)
+	sort.SliceStable(frames, func(i, j int) bool { return frames[i].Time < frames[j].Time })
+	if len(frames) < 2 || frames[0].Time != 0 || frames[len(frames)-1].Time != 1 {
+		return nil, ErrKeyframes
+	}
+	for i := range frames {
+		if i > 0 && frames[i].Time == frames[i-1].Time {
+			return nil, ErrKeyframes
+		}
+		if frames[i].Easing == nil {
+			frames[i].Easing = Linear
+		}
+	}
+	last := len(frames) - 1
+	return func(completed float64) float64 {
+		if math.IsNaN(completed) {
+			return completed
+		}
+		if completed <= 0 {
+			return frames[0].Value
+		}
+		if completed >= 1 {
+			return frames[last].Value
+		}
+		// Find the keyframe that ends the segment containing completed
+		i := sort.Search(len(frames), func(i int) bool { return frames[i].Time > completed })
+		from, to := frames[i-1], frames[i]
+		eased := from.Easing((completed - from.Time) / (to.Time - from.Time))
+		return from.Value + (to.Value-from.Value)*eased
+	}, nil
+}
@@ -0,0 +1,57 @@
+package curves_test
+
+import (
+	"math"
+
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Keyframes", func() {
+	It("should pass through every keyframe", func() {
+		fn, err := Keyframes(
+			Keyframe{Time: 0, Value: 0, Easing: EaseOutQuad},
+			Keyframe{Time: .4, Value: 1.2, Easing: EaseInOutSine},
+			Keyframe{Time: .7, Value: .9},
+			Keyframe{Time: 1, Value: 1},
+		)
+		Ω(err).Should(BeNil())
+		Ω(fn(0)).Should(Equal(0.))
+		Ω(fn(.4)).Should(Equal(1.2))
+		Ω(fn(.7)).Should(Equal(.9))
+		Ω(fn(1)).Should(Equal(1.))
+	})
+	It("should ease each segment with its own transition", func() {
+		fn, err := Keyframes(
+			Keyframe{Time: 0, Value: 0, Easing: EaseInQuad},
+			Keyframe{Time: .5, Value: 1},
+			Keyframe{Time: 1, Value: 0},
+		)
+		Ω(err).Should(BeNil())
+		Ω(fn(.25)).Should(BeNumerically("~", EaseInQuad(.5), 1e-12))
+		Ω(fn(.75)).Should(BeNumerically("~", .5, 1e-12))
+	})
+	It("should sort keyframes by time", func() {
+		fn, err := Keyframes(
+			Keyframe{Time: 1, Value: 1},
+			Keyframe{Time: 0, Value: 0},
+		)
+		Ω(err).Should(BeNil())
+		Ω(fn(.3)).Should(BeNumerically("~", .3, 1e-12))
+	})
+	It("should reject keyframes that don't cover the transition", func() {
+		_, err := Keyframes(Keyframe{Time: 0})
+		Ω(err).Should(Equal(ErrKeyframes))
+		_, err = Keyframes(Keyframe{Time: .1}, Keyframe{Time: 1})
+		Ω(err).Should(Equal(ErrKeyframes))
+		_, err = Keyframes(Keyframe{Time: 0}, Keyframe{Time: .5}, Keyframe{Time: .5}, Keyframe{Time: 1})
+		Ω(err).Should(Equal(ErrKeyframes))
+	})
+	It("should return NaN for NaN input", func() {
+		fn, err := Keyframes(Keyframe{Time: 0, Value: 0}, Keyframe{Time: 1, Value: 1})
+		Ω(err).Should(BeNil())
+		Ω(math.IsNaN(fn(math.NaN()))).Should(BeTrue())
+	})
+})
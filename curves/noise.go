@@ -0,0 +1,120 @@
+package curves
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/gopackage/tween"
+)
+
+// Perlin creates a one dimensional Perlin gradient noise function for the
+// seed. The noise varies smoothly between roughly -1 and 1, is 0 at every
+// integer and repeats every 256 units. The same seed always produces the same
+// noise.
+func Perlin(seed int64) func(x float64) float64 {
+	r := rand.New(rand.NewSource(seed))
+	gradients := make([]float64, 256)
+	for i := range gradients {
+		gradients[i] = r.Float64()*2 - 1
+	}
+	perm := r.Perm(256)
+	return func(x float64) float64 {
+		x0 := math.Floor(x)
+		t := x - x0
+		i := int(x0) & 255
+		g0 := gradients[perm[i]]
+		g1 := gradients[perm[(i+1)&255]]
+		// Perlin's fade curve blends the two gradient ramps
+		return 2 * (g0*t + (g1*(t-1)-g0*t)*Smootherstep(t))
+	}
+}
+
+// Noise perturbs a transition with seeded Perlin noise for organic motion
+// such as camera shake or a hand-drawn wobble. frequency is the number of
+// noise cycles over the transition and strength the largest displacement.
+// The noise fades out towards the ends so the transition still starts and
+// ends exactly at the values of base.
+func Noise(base tween.TransitionFunc, seed int64, frequency, strength float64) tween.TransitionFunc {
+	noise := Perlin(seed)
+	return func(completed float64) float64 {
+		if completed <= 0 || completed >= 1 {
+			return base(completed)
+		}
+		return base(completed) + strength*noise(completed*frequency)*math.Sin(completed*math.Pi)
+	}
+}
+
+// JitteredSteps is like Steps with JumpEnd but each jump happens at a
+// randomly shifted time. jitter (0.0 - 1.0) is how far a jump may move as a
+// fraction of the step length. The same seed always produces the same steps
+// and the transition still ends exactly at 1.
+func JitteredSteps(n int, seed int64, jitter float64) tween.TransitionFunc {
+	if n < 1 {
+		n = 1
+	}
+	jitter = math.Max(0, math.Min(1, jitter))
+	r := rand.New(rand.NewSource(seed))
+	jumps := make([]float64, n-1) // jumps holds the time of each jump
+	for i := range jumps {
+		jumps[i] = (float64(i+1) + (r.Float64()-.5)*jitter) / float64(n)
+	}
+	return func(completed float64) float64 {
+		if completed >= 1 {
+			return 1
+		}
+		return float64(sort.Search(len(jumps), func(i int) bool { return jumps[i] > completed })) / float64(n)
+	}
+}
+
+// RoughOptions configures a Rough transition.
+type RoughOptions struct {
+	Seed      int64   // Seed selects the random perturbation, so it replays identically.
+	Points    int     // Points is the number of perturbed points along the transition (defaults to 20).
+	Strength  float64 // Strength is the largest displacement of a point from the base transition.
+	Randomize bool    // Randomize spaces the points randomly rather than evenly.
+	Clamp     bool    // Clamp keeps the points within 0.0 - 1.0.
+}
+
+// Rough creates a jagged version of a base transition, like the GreenSock
+// rough ease: points along the base transition are moved up or down by a
+// random amount up to the strength and joined with straight lines. The
+// transition starts and ends exactly at the values of base. NaN returns NaN.
+func Rough(base tween.TransitionFunc, opts RoughOptions) tween.TransitionFunc {
+	if opts.Points <= 0 {
+		opts.Points = 20
+	}
+	r := rand.New(rand.NewSource(opts.Seed))
+	n := opts.Points
+	x := make([]float64, n+2)
+	y := make([]float64, n+2)
+	x[n+1] = 1
+	for i := 1; i <= n; i++ {
+		if opts.Randomize {
+			x[i] = r.Float64()
+		} else {
+			x[i] = float64(i) / float64(n+1)
+		}
+	}
+	sort.Float64s(x)
+	y[0], y[n+1] = base(0), base(1)
+	for i := 1; i <= n; i++ {
+		y[i] = base(x[i]) + (r.Float64()*2-1)*opts.Strength
+		if opts.Clamp {
+			y[i] = math.Max(0, math.Min(1, y[i]))
+		}
+	}
+	return func(completed float64) float64 {
+		if math.IsNaN(completed) {
+			return completed
+		}
+		if completed <= 0 || completed >= 1 {
+			return base(completed)
+		}
+		i := sort.SearchFloat64s(x, completed)
+		if x[i] == x[i-1] {
+			return y[i]
+		}
+		return y[i-1] + (y[i]-y[i-1])*(completed-x[i-1])/(x[i]-x[i-1])
+	}
+}
@@ -0,0 +1,92 @@
+package curves_test
+
+import (
+	"math"
+
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Noise Curves", func() {
+	Describe("Perlin", func() {
+		It("should be deterministic for a seed", func() {
+			a, b, c := Perlin(42), Perlin(42), Perlin(7)
+			different := false
+			for x := 0.; x < 10; x += .37 {
+				Ω(a(x)).Should(Equal(b(x)))
+				different = different || a(x) != c(x)
+				Ω(math.Abs(a(x))).Should(BeNumerically("<=", 1))
+			}
+			Ω(different).Should(BeTrue())
+		})
+		It("should be zero at integers", func() {
+			noise := Perlin(1)
+			for x := 0.; x < 10; x++ {
+				Ω(noise(x)).Should(BeNumerically("~", 0, 1e-12))
+			}
+		})
+	})
+	Describe("Noise", func() {
+		It("should keep exact end points", func() {
+			fn := Noise(EaseInOutQuad, 3, 8, .2)
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(1)).Should(Equal(1.))
+			moved := false
+			for x := 0.; x <= 1; x += .01 {
+				Ω(math.Abs(fn(x) - EaseInOutQuad(x))).Should(BeNumerically("<=", .2))
+				moved = moved || math.Abs(fn(x)-EaseInOutQuad(x)) > 1e-3
+			}
+			Ω(moved).Should(BeTrue())
+		})
+	})
+	Describe("JitteredSteps", func() {
+		It("should step through every value", func() {
+			fn := JitteredSteps(4, 9, .5)
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(.5)).Should(BeNumerically(">=", .25))
+			Ω(fn(.5)).Should(BeNumerically("<=", .5))
+			Ω(fn(.99)).Should(Equal(.75))
+			Ω(fn(1)).Should(Equal(1.))
+		})
+		It("should match Steps without jitter", func() {
+			fn := JitteredSteps(5, 1, 0)
+			for i := 0; i <= 97; i++ {
+				x := float64(i) / 97
+				Ω(fn(x)).Should(Equal(Steps(5, JumpEnd)(x)))
+			}
+		})
+		It("should replay identically for a seed", func() {
+			a, b := JitteredSteps(8, 5, 1), JitteredSteps(8, 5, 1)
+			for x := 0.; x <= 1; x += .01 {
+				Ω(a(x)).Should(Equal(b(x)))
+			}
+		})
+	})
+	Describe("Rough", func() {
+		It("should stay within strength of the base transition", func() {
+			fn := Rough(Linear, RoughOptions{Seed: 11, Points: 30, Strength: .1})
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(1)).Should(Equal(1.))
+			for x := 0.; x <= 1; x += .01 {
+				Ω(math.Abs(fn(x) - x)).Should(BeNumerically("<=", .1+1e-12))
+			}
+			Ω(math.IsNaN(fn(math.NaN()))).Should(BeTrue())
+		})
+		It("should clamp to the transition range", func() {
+			fn := Rough(Linear, RoughOptions{Seed: 2, Strength: .5, Randomize: true, Clamp: true})
+			for x := 0.; x <= 1; x += .01 {
+				Ω(fn(x)).Should(BeNumerically(">=", 0))
+				Ω(fn(x)).Should(BeNumerically("<=", 1))
+			}
+		})
+		It("should replay identically for a seed", func() {
+			opts := RoughOptions{Seed: 99, Strength: .3, Randomize: true}
+			a, b := Rough(EaseOutQuad, opts), Rough(EaseOutQuad, opts)
+			for x := 0.; x <= 1; x += .01 {
+				Ω(a(x)).Should(Equal(b(x)))
+			}
+		})
+	})
+})
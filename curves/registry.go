@@ -0,0 +1,341 @@
+package curves
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gopackage/tween"
+)
+
+// ErrUnknownCurve is returned by Parse when a curve name is not registered.
+var ErrUnknownCurve = errors.New("curves: unknown curve")
+
+// ErrSyntax is returned by Parse when an easing expression is malformed.
+var ErrSyntax = errors.New("curves: invalid easing expression")
+
+// registry holds the named curves available to Lookup and Parse.
+var registry = struct {
+	sync.RWMutex
+	names []string                        // names lists registered names in registration order
+	funcs map[string]tween.TransitionFunc // funcs maps normalized names to curves
+	code  map[uintptr][]string            // code maps function code pointers to names
+}{
+	funcs: map[string]tween.TransitionFunc{},
+	code:  map[uintptr][]string{},
+}
+
+func init() {
+	for _, c := range []struct {
+		name string
+		fn   tween.TransitionFunc
+	}{
+		{"linear", Linear},
+		{"swing", Swing},
+		{"smoothstep", Smoothstep},
+		{"smootherstep", Smootherstep},
+		{"ease", Ease},
+		{"ease-in", EaseIn},
+		{"ease-out", EaseOut},
+		{"ease-in-out", EaseInOut},
+		{"step-start", StepStart},
+		{"step-end", StepEnd},
+		{"easeInQuad", EaseInQuad},
+		{"easeOutQuad", EaseOutQuad},
+		{"easeInOutQuad", EaseInOutQuad},
+		{"easeInCubic", EaseInCubic},
+		{"easeOutCubic", EaseOutCubic},
+		{"easeInOutCubic", EaseInOutCubic},
+		{"easeInQuart", EaseInQuart},
+		{"easeOutQuart", EaseOutQuart},
+		{"easeInOutQuart", EaseInOutQuart},
+		{"easeInQuint", EaseInQuint},
+		{"easeOutQuint", EaseOutQuint},
+		{"easeInOutQuint", EaseInOutQuint},
+		{"easeInExpo", EaseInExpo},
+		{"easeOutExpo", EaseOutExpo},
+		{"easeInOutExpo", EaseInOutExpo},
+		{"easeInSine", EaseInSine},
+		{"easeOutSine", EaseOutSine},
+		{"easeInOutSine", EaseInOutSine},
+		{"easeInCirc", EaseInCirc},
+		{"easeOutCirc", EaseOutCirc},
+		{"easeInOutCirc", EaseInOutCirc},
+		{"easeInElastic", EaseInElastic},
+		{"easeOutElastic", EaseOutElastic},
+		{"easeInOutElastic", EaseInOutElastic},
+		{"easeInBack", EaseInBack},
+		{"easeOutBack", EaseOutBack},
+		{"easeInOutBack", EaseInOutBack},
+		{"easeInBounce", EaseInBounce},
+		{"easeOutBounce", EaseOutBounce},
+		{"easeInOutBounce", EaseInOutBounce},
+	} {
+		Register(c.name, c.fn)
+	}
+}
+
+// normalize folds a curve name so that "easeInOutQuad", "ease-in-out-quad"
+// and "EASE_IN_OUT_QUAD" all refer to the same curve.
+func normalize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', '_', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(name))
+}
+
+// Register adds a named curve so it can be found with Lookup and Parse.
+// Registering a name that already exists replaces the curve. Names are
+// matched ignoring case, dashes and underscores. Register panics if the name
+// is empty or the curve is nil.
+func Register(name string, fn tween.TransitionFunc) {
+	key := normalize(name)
+	if key == "" || fn == nil {
+		panic("curves: Register needs a name and a curve")
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	forget(key)
+	registry.names = append(registry.names, name)
+	registry.funcs[key] = fn
+	ptr := reflect.ValueOf(fn).Pointer()
+	registry.code[ptr] = append(registry.code[ptr], name)
+}
+
+// Unregister removes a named curve added with Register. It reports whether
+// the name was registered.
+func Unregister(name string) bool {
+	registry.Lock()
+	defer registry.Unlock()
+	return forget(normalize(name))
+}
+
+// forget removes the curve registered under a normalized key. The registry
+// must be locked.
+func forget(key string) bool {
+	old, ok := registry.funcs[key]
+	if !ok {
+		return false
+	}
+	for i, n := range registry.names {
+		if normalize(n) == key {
+			registry.names = append(registry.names[:i], registry.names[i+1:]...)
+			break
+		}
+	}
+	ptr := reflect.ValueOf(old).Pointer()
+	names := registry.code[ptr]
+	for i, n := range names {
+		if normalize(n) == key {
+			registry.code[ptr] = append(names[:i:i], names[i+1:]...)
+			break
+		}
+	}
+	if len(registry.code[ptr]) == 0 {
+		delete(registry.code, ptr)
+	}
+	delete(registry.funcs, key)
+	return true
+}
+
+// Lookup finds a registered curve by name.
+func Lookup(name string) (tween.TransitionFunc, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	fn, ok := registry.funcs[normalize(name)]
+	return fn, ok
+}
+
+// Names lists the names of all registered curves in registration order.
+func Names() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	return append([]string(nil), registry.names...)
+}
+
+// NameOf finds the registered name of a curve so it can be serialized. Go
+// functions can't be compared directly, so curves created by the same
+// constructor (e.g. two CubicBezier curves) are told apart by sampling them;
+// a curve that was never registered has no name. Use Curve to keep the
+// easing expression of parsed curves.
+func NameOf(fn tween.TransitionFunc) (string, bool) {
+	if fn == nil {
+		return "", false
+	}
+	registry.RLock()
+	defer registry.RUnlock()
+	names := registry.code[reflect.ValueOf(fn).Pointer()]
+	for _, name := range names {
+		if same(fn, registry.funcs[normalize(name)]) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// same reports if two curves produce identical values.
+func same(a, b tween.TransitionFunc) bool {
+	for i := 0; i <= 16; i++ {
+		x := float64(i) / 16
+		if a(x) != b(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse creates a curve from a name or CSS-like easing expression:
+//
+//	easeInOutQuad, ease-in-out, linear        registered curves
+//	cubic-bezier(.17, .67, .83, .67)          see CubicBezier
+//	steps(4, jump-end)                        see Steps
+//	spring(1, 100, 10, 0)                     see Spring
+//	logistic(10)                              see Logistic
+//	bernstein(0, .1, .9, 1)                   see Bernstein
+//	slow-mo(.7, .7)                           see SlowMo
+func Parse(spec string) (tween.TransitionFunc, error) {
+	spec = strings.TrimSpace(spec)
+	open := strings.IndexByte(spec, '(')
+	if open < 0 {
+		if fn, ok := Lookup(spec); ok {
+			return fn, nil
+		}
+		return nil, fmt.Errorf("%w %q", ErrUnknownCurve, spec)
+	}
+	if !strings.HasSuffix(spec, ")") {
+		return nil, fmt.Errorf("%w %q: missing closing parenthesis", ErrSyntax, spec)
+	}
+	name := normalize(spec[:open])
+	args := strings.Split(spec[open+1:len(spec)-1], ",")
+	for i := range args {
+		args[i] = strings.TrimSpace(args[i])
+	}
+	switch name {
+	case "cubicbezier":
+		v, err := numbers(spec, args, 4)
+		if err != nil {
+			return nil, err
+		}
+		if v[0] < 0 || v[0] > 1 || v[2] < 0 || v[2] > 1 {
+			return nil, fmt.Errorf("%w %q: x values must be between 0 and 1", ErrSyntax, spec)
+		}
+		return CubicBezier(v[0], v[1], v[2], v[3]), nil
+	case "steps":
+		if len(args) < 1 || len(args) > 2 {
+			return nil, fmt.Errorf("%w %q: steps needs 1 or 2 arguments", ErrSyntax, spec)
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("%w %q: step count must be a positive integer", ErrSyntax, spec)
+		}
+		jump := JumpEnd
+		if len(args) == 2 {
+			switch normalize(args[1]) {
+			case "jumpend", "end":
+				jump = JumpEnd
+			case "jumpstart", "start":
+				jump = JumpStart
+			case "jumpnone":
+				jump = JumpNone
+			case "jumpboth":
+				jump = JumpBoth
+			default:
+				return nil, fmt.Errorf("%w %q: unknown jump term %q", ErrSyntax, spec, args[1])
+			}
+		}
+		if jump == JumpNone && n < 2 {
+			return nil, fmt.Errorf("%w %q: jump-none needs at least 2 steps", ErrSyntax, spec)
+		}
+		return Steps(n, jump), nil
+	case "spring":
+		v, err := numbers(spec, args, 4)
+		if err != nil {
+			return nil, err
+		}
+		fn, err := Spring(v[0], v[1], v[2], v[3])
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %v", ErrSyntax, spec, err)
+		}
+		return fn, nil
+	case "logistic":
+		v, err := numbers(spec, args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return Logistic(v[0]), nil
+	case "bernstein":
+		v, err := numbers(spec, args, len(args))
+		if err != nil {
+			return nil, err
+		}
+		return Bernstein(v...), nil
+	case "slowmo":
+		v, err := numbers(spec, args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return SlowMo(v[0], v[1]), nil
+	}
+	return nil, fmt.Errorf("%w %q", ErrUnknownCurve, spec)
+}
+
+// numbers parses exactly n numeric arguments.
+func numbers(spec string, args []string, n int) ([]float64, error) {
+	if len(args) != n {
+		return nil, fmt.Errorf("%w %q: need %d arguments", ErrSyntax, spec, n)
+	}
+	v := make([]float64, n)
+	for i, arg := range args {
+		f, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %q is not a number", ErrSyntax, spec, arg)
+		}
+		v[i] = f
+	}
+	return v, nil
+}
+
+// Curve is a curve together with the easing expression it was parsed from.
+// Curve implements encoding.TextMarshaler and encoding.TextUnmarshaler so it
+// can be used directly in JSON and YAML configuration.
+type Curve struct {
+	Spec string               // Spec is the name or easing expression of the curve.
+	Func tween.TransitionFunc // Func is the curve.
+}
+
+// ParseCurve parses an easing expression into a Curve.
+func ParseCurve(spec string) (Curve, error) {
+	fn, err := Parse(spec)
+	if err != nil {
+		return Curve{}, err
+	}
+	return Curve{Spec: strings.TrimSpace(spec), Func: fn}, nil
+}
+
+// MarshalText returns the easing expression of the curve. If the curve has
+// no Spec the registered name of Func is used.
+func (c Curve) MarshalText() ([]byte, error) {
+	if c.Spec != "" {
+		return []byte(c.Spec), nil
+	}
+	if name, ok := NameOf(c.Func); ok {
+		return []byte(name), nil
+	}
+	return nil, fmt.Errorf("%w: curve has no registered name", ErrUnknownCurve)
+}
+
+// UnmarshalText parses an easing expression into the curve.
+func (c *Curve) UnmarshalText(text []byte) error {
+	curve, err := ParseCurve(string(text))
+	if err != nil {
+		return err
+	}
+	*c = curve
+	return nil
+}
@@ -0,0 +1,136 @@
+package curves_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Registry", func() {
+	It("should find curves by name", func() {
+		fn, ok := Lookup("easeInOutQuad")
+		Ω(ok).Should(BeTrue())
+		Ω(fn(.3)).Should(Equal(EaseInOutQuad(.3)))
+		fn, ok = Lookup("EASE_IN_OUT_QUAD")
+		Ω(ok).Should(BeTrue())
+		Ω(fn(.3)).Should(Equal(EaseInOutQuad(.3)))
+		fn, ok = Lookup("ease-in-out")
+		Ω(ok).Should(BeTrue())
+		Ω(fn(.3)).Should(Equal(EaseInOut(.3)))
+		_, ok = Lookup("nope")
+		Ω(ok).Should(BeFalse())
+	})
+	It("should register every predefined curve", func() {
+		for _, curve := range funcs {
+			fn, ok := Lookup(curve.Name)
+			Ω(ok).Should(BeTrue(), curve.Name)
+			Ω(fn(.7)).Should(Equal(curve.Func(.7)), curve.Name)
+		}
+		Ω(Names()).Should(ContainElement("linear"))
+	})
+	Context("with custom curves", func() {
+		AfterEach(func() {
+			Unregister("half")
+		})
+		It("should register custom curves", func() {
+			Register("half", Remap(Linear, 0, .5))
+			fn, err := Parse("half")
+			Ω(err).Should(BeNil())
+			Ω(fn(1)).Should(Equal(.5))
+			Register("half", Remap(Linear, 0, .25))
+			fn, _ = Lookup("half")
+			Ω(fn(1)).Should(Equal(.25))
+			Ω(Names()).Should(ContainElement("half"))
+		})
+		It("should unregister custom curves", func() {
+			names := Names()
+			Register("half", Remap(Linear, 0, .5))
+			Ω(Unregister("HALF")).Should(BeTrue())
+			Ω(Unregister("half")).Should(BeFalse())
+			_, ok := Lookup("half")
+			Ω(ok).Should(BeFalse())
+			Ω(Names()).Should(Equal(names))
+		})
+	})
+	It("should find the name of a registered curve", func() {
+		name, ok := NameOf(EaseOutBounce)
+		Ω(ok).Should(BeTrue())
+		Ω(name).Should(Equal("easeOutBounce"))
+		name, ok = NameOf(EaseIn)
+		Ω(ok).Should(BeTrue())
+		Ω(name).Should(Equal("ease-in"))
+		_, ok = NameOf(CubicBezier(.1, .2, .3, .4))
+		Ω(ok).Should(BeFalse())
+	})
+	Describe("Parse", func() {
+		It("should parse cubic-bezier expressions", func() {
+			fn, err := Parse("cubic-bezier(.42, 0, .58, 1)")
+			Ω(err).Should(BeNil())
+			Ω(fn(.3)).Should(Equal(EaseInOut(.3)))
+			_, err = Parse("cubic-bezier(2, 0, .58, 1)")
+			Ω(errors.Is(err, ErrSyntax)).Should(BeTrue())
+			_, err = Parse("cubic-bezier(.42, 0, .58)")
+			Ω(errors.Is(err, ErrSyntax)).Should(BeTrue())
+		})
+		It("should parse steps expressions", func() {
+			fn, err := Parse("steps(4, jump-end)")
+			Ω(err).Should(BeNil())
+			Ω(fn(.3)).Should(Equal(.25))
+			fn, err = Parse("steps(4,start)")
+			Ω(err).Should(BeNil())
+			Ω(fn(.3)).Should(Equal(.5))
+			fn, err = Parse("steps(2)")
+			Ω(err).Should(BeNil())
+			Ω(fn(.6)).Should(Equal(.5))
+			_, err = Parse("steps(1, jump-none)")
+			Ω(errors.Is(err, ErrSyntax)).Should(BeTrue())
+			_, err = Parse("steps(1, sideways)")
+			Ω(errors.Is(err, ErrSyntax)).Should(BeTrue())
+		})
+		It("should parse spring expressions", func() {
+			fn, err := Parse("spring(1, 100, 10, 0)")
+			Ω(err).Should(BeNil())
+			Ω(fn(1)).Should(Equal(1.))
+			_, err = Parse("spring(0, 100, 10, 0)")
+			Ω(errors.Is(err, ErrSyntax)).Should(BeTrue())
+		})
+		It("should report unknown curves", func() {
+			_, err := Parse("wobble")
+			Ω(errors.Is(err, ErrUnknownCurve)).Should(BeTrue())
+			_, err = Parse("wobble(1)")
+			Ω(errors.Is(err, ErrUnknownCurve)).Should(BeTrue())
+			_, err = Parse("steps(1")
+			Ω(errors.Is(err, ErrSyntax)).Should(BeTrue())
+		})
+	})
+	Describe("Curve", func() {
+		It("should round trip through JSON", func() {
+			var config struct {
+				Fade  Curve `json:"fade"`
+				Slide Curve `json:"slide"`
+			}
+			err := json.Unmarshal([]byte(`{"fade":"ease-in","slide":"cubic-bezier(.17,.67,.83,.67)"}`), &config)
+			Ω(err).Should(BeNil())
+			Ω(config.Fade.Func(.5)).Should(Equal(EaseIn(.5)))
+			Ω(config.Slide.Spec).Should(Equal("cubic-bezier(.17,.67,.83,.67)"))
+			data, err := json.Marshal(config)
+			Ω(err).Should(BeNil())
+			Ω(string(data)).Should(Equal(`{"fade":"ease-in","slide":"cubic-bezier(.17,.67,.83,.67)"}`))
+		})
+		It("should marshal registered curves without a spec", func() {
+			data, err := json.Marshal(Curve{Func: EaseInQuad})
+			Ω(err).Should(BeNil())
+			Ω(string(data)).Should(Equal(`"easeInQuad"`))
+			_, err = json.Marshal(Curve{Func: Remap(Linear, 0, 2)})
+			Ω(err).ShouldNot(BeNil())
+		})
+		It("should report invalid expressions", func() {
+			var c Curve
+			Ω(json.Unmarshal([]byte(`"wobble"`), &c)).ShouldNot(Succeed())
+		})
+	})
+})
@@ -0,0 +1,151 @@
+package curves
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gopackage/tween"
+)
+
+// ErrEndpoints is returned by FromSamples when the samples don't start at 0
+// and end at 1.
+var ErrEndpoints = errors.New("curves: samples must start at 0 and end at 1")
+
+// Interpolation selects how a transition is calculated between samples.
+type Interpolation int
+
+const (
+	// LinearInterpolation joins the samples with straight lines.
+	LinearInterpolation Interpolation = iota
+	// MonotoneInterpolation joins the samples with a monotone cubic spline
+	// (see MonotoneCubic) that is smooth and never overshoots.
+	MonotoneInterpolation
+)
+
+// DefaultTolerance is how far recorded samples may be from 0 and 1 at their
+// ends when SampleOptions.Tolerance is not set.
+const DefaultTolerance = 1e-3
+
+// SampleOptions controls how samples are turned into a transition.
+type SampleOptions struct {
+	Interpolation Interpolation // Interpolation between samples.
+	Normalize     bool          // Normalize scales the values so the first is 0 and the last is 1.
+	Tolerance     float64       // Tolerance is how far the end values may be from 0 and 1 (defaults to DefaultTolerance).
+}
+
+// FromSamples creates a transition from recorded sample points, such as a
+// timing curve exported from an animation tool. The X values (e.g. time in
+// frames or milliseconds) are sorted and scaled to 0.0 - 1.0. The first and
+// last Y values must be 0 and 1 within the tolerance, or be normalized to
+// them, and are snapped so the transition ends exactly.
+func FromSamples(points []Point, opts SampleOptions) (tween.TransitionFunc, error) {
+	h, err := newHermite(points, nil)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Tolerance == 0 {
+		opts.Tolerance = DefaultTolerance
+	}
+	last := len(h.y) - 1
+	if opts.Normalize {
+		start, end := h.y[0], h.y[last]
+		if start == end {
+			return nil, fmt.Errorf("%w: first and last values are both %v", ErrEndpoints, start)
+		}
+		for i := range h.y {
+			h.y[i] = (h.y[i] - start) / (end - start)
+		}
+	}
+	if math.Abs(h.y[0]) > opts.Tolerance || math.Abs(h.y[last]-1) > opts.Tolerance {
+		return nil, fmt.Errorf("%w: got %v to %v", ErrEndpoints, h.y[0], h.y[last])
+	}
+	h.y[0], h.y[last] = 0, 1
+	switch opts.Interpolation {
+	case LinearInterpolation:
+		return h.linear, nil
+	case MonotoneInterpolation:
+		h.monotone()
+		return h.at, nil
+	}
+	return nil, fmt.Errorf("curves: unknown interpolation %d", opts.Interpolation)
+}
+
+// linear evaluates the samples joined by straight lines. NaN returns NaN.
+func (h *hermite) linear(completed float64) float64 {
+	last := len(h.x) - 1
+	if math.IsNaN(completed) {
+		return completed
+	}
+	if completed <= 0 {
+		return h.y[0]
+	}
+	if completed >= 1 {
+		return h.y[last]
+	}
+	i := sort.SearchFloat64s(h.x, completed)
+	return h.y[i-1] + (h.y[i]-h.y[i-1])*(completed-h.x[i-1])/(h.x[i]-h.x[i-1])
+}
+
+// LoadCSV reads sample points from CSV with the X value in the first column
+// and the Y value in the second, and creates a transition with FromSamples.
+// A header row and lines starting with # are skipped.
+func LoadCSV(r io.Reader, opts SampleOptions) (tween.TransitionFunc, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	points := []Point{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("curves: sample line %d needs x and y columns", line)
+		}
+		x, errX := strconv.ParseFloat(strings.TrimSpace(record[0]), 64)
+		y, errY := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if errX != nil || errY != nil {
+			if len(points) == 0 {
+				continue // header row
+			}
+			return nil, fmt.Errorf("curves: sample line %d is not numeric: %q", line, record)
+		}
+		points = append(points, Point{x, y})
+	}
+	return FromSamples(points, opts)
+}
+
+// LoadJSON reads sample points from a JSON array of [x, y] pairs or
+// {"x": x, "y": y} objects and creates a transition with FromSamples.
+func LoadJSON(r io.Reader, opts SampleOptions) (tween.TransitionFunc, error) {
+	raw := []json.RawMessage{}
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+	points := make([]Point, len(raw))
+	for i, sample := range raw {
+		pair := []float64{}
+		if err := json.Unmarshal(sample, &pair); err == nil {
+			if len(pair) != 2 {
+				return nil, fmt.Errorf("curves: sample %d needs an x and y value", i)
+			}
+			points[i] = Point{pair[0], pair[1]}
+			continue
+		}
+		if err := json.Unmarshal(sample, &points[i]); err != nil {
+			return nil, fmt.Errorf("curves: sample %d: %v", i, err)
+		}
+	}
+	return FromSamples(points, opts)
+}
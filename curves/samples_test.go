@@ -0,0 +1,85 @@
+package curves_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Samples", func() {
+	Describe("FromSamples", func() {
+		It("should interpolate linearly", func() {
+			fn, err := FromSamples([]Point{{0, 0}, {10, .8}, {20, 1}}, SampleOptions{})
+			Ω(err).Should(BeNil())
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(.25)).Should(BeNumerically("~", .4, 1e-12))
+			Ω(fn(.75)).Should(BeNumerically("~", .9, 1e-12))
+			Ω(fn(1)).Should(Equal(1.))
+			Ω(math.IsNaN(fn(math.NaN()))).Should(BeTrue())
+		})
+		It("should interpolate with a monotone cubic", func() {
+			points := []Point{{0, 0}, {1, .1}, {2, .8}, {3, 1}}
+			fn, err := FromSamples(points, SampleOptions{Interpolation: MonotoneInterpolation})
+			Ω(err).Should(BeNil())
+			spline, _ := MonotoneCubic(points)
+			Ω(fn(.4)).Should(Equal(spline(.4)))
+		})
+		It("should normalize the values", func() {
+			fn, err := FromSamples([]Point{{0, 100}, {1, 150}, {2, 300}}, SampleOptions{Normalize: true})
+			Ω(err).Should(BeNil())
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(.5)).Should(BeNumerically("~", .25, 1e-12))
+			Ω(fn(1)).Should(Equal(1.))
+			_, err = FromSamples([]Point{{0, 1}, {1, 2}, {2, 1}}, SampleOptions{Normalize: true})
+			Ω(errors.Is(err, ErrEndpoints)).Should(BeTrue())
+		})
+		It("should snap end values within the tolerance", func() {
+			fn, err := FromSamples([]Point{{0, .0004}, {1, .9995}}, SampleOptions{})
+			Ω(err).Should(BeNil())
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(1)).Should(Equal(1.))
+			_, err = FromSamples([]Point{{0, .0004}, {1, .9995}}, SampleOptions{Tolerance: 1e-4})
+			Ω(errors.Is(err, ErrEndpoints)).Should(BeTrue())
+			_, err = FromSamples([]Point{{0, 0}, {1, .5}}, SampleOptions{})
+			Ω(errors.Is(err, ErrEndpoints)).Should(BeTrue())
+		})
+		It("should validate points", func() {
+			_, err := FromSamples([]Point{{0, 0}}, SampleOptions{})
+			Ω(err).Should(Equal(ErrPoints))
+		})
+	})
+	Describe("LoadCSV", func() {
+		It("should load samples with a header", func() {
+			fn, err := LoadCSV(strings.NewReader("# exported timing\ntime,value\n0,0\n 12, 0.5\n24,1\n"), SampleOptions{})
+			Ω(err).Should(BeNil())
+			Ω(fn(.5)).Should(BeNumerically("~", .5, 1e-12))
+			Ω(fn(.25)).Should(BeNumerically("~", .25, 1e-12))
+		})
+		It("should report bad rows", func() {
+			_, err := LoadCSV(strings.NewReader("0,0\nx,y\n1,1\n"), SampleOptions{})
+			Ω(err).ShouldNot(BeNil())
+			_, err = LoadCSV(strings.NewReader("0\n1\n"), SampleOptions{})
+			Ω(err).ShouldNot(BeNil())
+		})
+	})
+	Describe("LoadJSON", func() {
+		It("should load pairs and objects", func() {
+			fn, err := LoadJSON(strings.NewReader(`[[0, 0], {"x": 50, "y": 0.2}, [100, 1]]`), SampleOptions{})
+			Ω(err).Should(BeNil())
+			Ω(fn(.5)).Should(BeNumerically("~", .2, 1e-12))
+		})
+		It("should report bad samples", func() {
+			_, err := LoadJSON(strings.NewReader(`[[0, 0, 0], [1, 1]]`), SampleOptions{})
+			Ω(err).ShouldNot(BeNil())
+			_, err = LoadJSON(strings.NewReader(`[[0, 0], "one"]`), SampleOptions{})
+			Ω(err).ShouldNot(BeNil())
+			_, err = LoadJSON(strings.NewReader(`{}`), SampleOptions{})
+			Ω(err).ShouldNot(BeNil())
+		})
+	})
+})
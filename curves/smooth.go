@@ -0,0 +1,82 @@
+package curves
+
+import (
+	"math"
+
+	"github.com/gopackage/tween"
+)
+
+// Smoothstep is the Hermite smoothstep polynomial 3x² - 2x³. It eases in and
+// out with zero velocity at both ends (C1 continuous).
+func Smoothstep(completed float64) float64 {
+	return completed * completed * (3 - 2*completed)
+}
+
+// Smootherstep is Ken Perlin's smootherstep polynomial 6x⁵ - 15x⁴ + 10x³. It
+// eases in and out with zero velocity and acceleration at both ends (C2
+// continuous).
+func Smootherstep(completed float64) float64 {
+	return completed * completed * completed * (completed*(completed*6-15) + 10)
+}
+
+// Logistic creates an ease in and out transition from the logistic sigmoid
+// function, scaled so it runs exactly from 0 to 1. Higher steepness gives a
+// sharper transition in the middle; a steepness of 0 is Linear.
+func Logistic(steepness float64) tween.TransitionFunc {
+	if steepness == 0 {
+		return Linear
+	}
+	sigmoid := func(x float64) float64 {
+		return 1 / (1 + math.Exp(-steepness*(x-0.5)))
+	}
+	start, end := sigmoid(0), sigmoid(1)
+	return func(completed float64) float64 {
+		return (sigmoid(completed) - start) / (end - start)
+	}
+}
+
+// Bernstein creates a one dimensional Bézier transition from the control
+// values using Bernstein polynomials of degree len(values)-1. The transition
+// starts at the first value and ends at the last, so Bernstein(0, 0, 1, 1)
+// is Smoothstep. With no values the transition is Linear.
+func Bernstein(values ...float64) tween.TransitionFunc {
+	if len(values) == 0 {
+		return Linear
+	}
+	v := append([]float64(nil), values...)
+	return func(completed float64) float64 {
+		// de Casteljau's algorithm is numerically stable for any degree
+		work := append(make([]float64, 0, len(v)), v...)
+		for n := len(work) - 1; n > 0; n-- {
+			for i := 0; i < n; i++ {
+				work[i] += (work[i+1] - work[i]) * completed
+			}
+		}
+		return work[0]
+	}
+}
+
+// SlowMo creates a GreenSock style slow motion transition that moves quickly,
+// slows to a near linear crawl for linearRatio (0.0 - 1.0) of the time, then
+// speeds up again. power (0.0 - 1.0) controls how slow the middle section is,
+// from Linear at 0 to nearly stopped at 1.
+func SlowMo(linearRatio, power float64) tween.TransitionFunc {
+	linearRatio = math.Max(0, math.Min(1, linearRatio))
+	if linearRatio == 1 {
+		power = 0
+	}
+	edge := (1 - linearRatio) / 2 // edge is the duration of the fast sections
+	end := edge + linearRatio     // end is where the slow section ends
+	return func(completed float64) float64 {
+		r := completed + (0.5-completed)*power
+		switch {
+		case completed < edge:
+			p := 1 - completed/edge
+			return r - p*p*p*p*r
+		case completed > end:
+			p := (completed - end) / edge
+			return r + (completed-r)*p*p*p*p
+		}
+		return r
+	}
+}
@@ -0,0 +1,80 @@
+package curves_test
+
+import (
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Smooth Curves", func() {
+	It("should smoothstep", func() {
+		Ω(Smoothstep(0)).Should(Equal(0.))
+		Ω(Smoothstep(.25)).Should(Equal(.15625))
+		Ω(Smoothstep(.5)).Should(Equal(.5))
+		Ω(Smoothstep(1)).Should(Equal(1.))
+	})
+	It("should smootherstep", func() {
+		Ω(Smootherstep(0)).Should(Equal(0.))
+		Ω(Smootherstep(.25)).Should(BeNumerically("~", .103515625, 1e-12))
+		Ω(Smootherstep(.5)).Should(Equal(.5))
+		Ω(Smootherstep(1)).Should(Equal(1.))
+	})
+	Describe("Logistic", func() {
+		It("should run exactly from 0 to 1", func() {
+			for _, k := range []float64{1, 10, 50} {
+				fn := Logistic(k)
+				Ω(fn(0)).Should(Equal(0.))
+				Ω(fn(.5)).Should(BeNumerically("~", .5, 1e-12))
+				Ω(fn(1)).Should(Equal(1.))
+			}
+		})
+		It("should get steeper", func() {
+			Ω(Logistic(20)(.4)).Should(BeNumerically("<", Logistic(5)(.4)))
+			Ω(Logistic(0)(.4)).Should(Equal(.4))
+		})
+	})
+	Describe("Bernstein", func() {
+		It("should match smoothstep for cubic control values", func() {
+			fn := Bernstein(0, 0, 1, 1)
+			for x := 0.; x <= 1; x += .1 {
+				Ω(fn(x)).Should(BeNumerically("~", Smoothstep(x), 1e-12))
+			}
+		})
+		It("should support any degree", func() {
+			Ω(Bernstein(0, 1)(.3)).Should(BeNumerically("~", .3, 1e-12))
+			Ω(Bernstein(.5)(.3)).Should(Equal(.5))
+			Ω(Bernstein()(.3)).Should(Equal(.3))
+			fn := Bernstein(0, 1.5, -.5, 1.2, 0, 1)
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(1)).Should(Equal(1.))
+		})
+	})
+	Describe("SlowMo", func() {
+		It("should be nearly flat in the middle", func() {
+			fn := SlowMo(.7, .7)
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(1)).Should(Equal(1.))
+			Ω(fn(.5)).Should(Equal(.5))
+			// The middle 70% of the time moves at 30% speed
+			Ω(fn(.85) - fn(.15)).Should(BeNumerically("~", .7*.3, 1e-12))
+		})
+		It("should be linear with no power or a full linear ratio", func() {
+			Ω(SlowMo(.5, 0)(.3)).Should(BeNumerically("~", .3, 1e-12))
+			Ω(SlowMo(1, .7)(.3)).Should(BeNumerically("~", .3, 1e-12))
+		})
+	})
+	It("should parse the new curves", func() {
+		for spec, want := range map[string]float64{
+			"smoothstep":              Smoothstep(.3),
+			"smootherstep":            Smootherstep(.3),
+			"logistic(10)":            Logistic(10)(.3),
+			"bernstein(0, .1, .9, 1)": Bernstein(0, .1, .9, 1)(.3),
+			"slow-mo(.7, .7)":         SlowMo(.7, .7)(.3),
+		} {
+			fn, err := Parse(spec)
+			Ω(err).Should(BeNil(), spec)
+			Ω(fn(.3)).Should(Equal(want), spec)
+		}
+	})
+})
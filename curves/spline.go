@@ -0,0 +1,186 @@
+package curves
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/gopackage/tween"
+)
+
+// ErrPoints is returned by the spline constructors when fewer than two points
+// are provided, a point is not a finite number or two points share the same X.
+var ErrPoints = errors.New("curves: need at least two finite points with unique X values")
+
+// ErrTangents is returned by Hermite when the number of tangents does not
+// match the number of points.
+var ErrTangents = errors.New("curves: need one finite tangent per point")
+
+// Point is a sample point that a spline passes through.
+type Point struct {
+	X float64 // X is the sample position, typically time.
+	Y float64 // Y is the sample value.
+}
+
+// CatmullRom creates a smooth transition passing through all of the points
+// using a cardinal spline. A tension of 0 is a classic Catmull-Rom spline and
+// a tension of 1 flattens the curve at every point.
+//
+// Points are sorted by X and the X range is scaled to 0.0 - 1.0 so recorded
+// samples can be used directly. The transition returns the Y of the first and
+// last point exactly at 0 and 1.
+func CatmullRom(points []Point, tension float64) (tween.TransitionFunc, error) {
+	h, err := newHermite(points, nil)
+	if err != nil {
+		return nil, err
+	}
+	last := len(h.x) - 1
+	for i := range h.m {
+		lo, hi := i-1, i+1
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > last {
+			hi = last
+		}
+		h.m[i] = (1 - tension) * (h.y[hi] - h.y[lo]) / (h.x[hi] - h.x[lo])
+	}
+	return h.at, nil
+}
+
+// MonotoneCubic creates a smooth transition passing through all of the points
+// using the Fritsch-Carlson method. The transition never overshoots the
+// points: wherever the samples are monotonic, so is the transition.
+//
+// Points are sorted and scaled as described in CatmullRom.
+func MonotoneCubic(points []Point) (tween.TransitionFunc, error) {
+	h, err := newHermite(points, nil)
+	if err != nil {
+		return nil, err
+	}
+	h.monotone()
+	return h.at, nil
+}
+
+// Hermite creates a smooth transition passing through all of the points with
+// the provided tangent (slope in the units of the points) at each point.
+//
+// Points are sorted and scaled as described in CatmullRom, with each tangent
+// staying attached to its point.
+func Hermite(points []Point, tangents []float64) (tween.TransitionFunc, error) {
+	if len(tangents) != len(points) {
+		return nil, ErrTangents
+	}
+	for _, m := range tangents {
+		if math.IsNaN(m) || math.IsInf(m, 0) {
+			return nil, ErrTangents
+		}
+	}
+	h, err := newHermite(points, tangents)
+	if err != nil {
+		return nil, err
+	}
+	return h.at, nil
+}
+
+// hermite is a piecewise cubic Hermite spline over X values scaled to 0 - 1.
+type hermite struct {
+	x []float64 // x is the scaled position of each point
+	y []float64 // y is the value of each point
+	m []float64 // m is the tangent at each point
+}
+
+// newHermite validates, sorts and scales the points. If tangents are given
+// they are sorted and scaled along with the points.
+func newHermite(points []Point, tangents []float64) (*hermite, error) {
+	if len(points) < 2 {
+		return nil, ErrPoints
+	}
+	order := make([]int, len(points))
+	for i, p := range points {
+		if math.IsNaN(p.X) || math.IsInf(p.X, 0) || math.IsNaN(p.Y) || math.IsInf(p.Y, 0) {
+			return nil, ErrPoints
+		}
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return points[order[i]].X < points[order[j]].X })
+
+	min, max := points[order[0]].X, points[order[len(order)-1]].X
+	scale := max - min
+	h := &hermite{
+		x: make([]float64, len(points)),
+		y: make([]float64, len(points)),
+		m: make([]float64, len(points)),
+	}
+	for i, o := range order {
+		if i > 0 && points[o].X == points[order[i-1]].X {
+			return nil, ErrPoints
+		}
+		h.x[i] = (points[o].X - min) / scale
+		h.y[i] = points[o].Y
+		if tangents != nil {
+			h.m[i] = tangents[o] * scale
+		}
+	}
+	// Guard against rounding so the ends land exactly on 0 and 1
+	h.x[0], h.x[len(h.x)-1] = 0, 1
+	return h, nil
+}
+
+// monotone sets the tangents using the Fritsch-Carlson method.
+func (h *hermite) monotone() {
+	n := len(h.x)
+	d := make([]float64, n-1) // d is the secant slope of each interval
+	for i := range d {
+		d[i] = (h.y[i+1] - h.y[i]) / (h.x[i+1] - h.x[i])
+	}
+	h.m[0], h.m[n-1] = d[0], d[n-2]
+	for i := 1; i < n-1; i++ {
+		if d[i-1]*d[i] <= 0 {
+			h.m[i] = 0
+		} else {
+			h.m[i] = (d[i-1] + d[i]) / 2
+		}
+	}
+	for i, s := range d {
+		if s == 0 {
+			h.m[i], h.m[i+1] = 0, 0
+			continue
+		}
+		a, b := h.m[i]/s, h.m[i+1]/s
+		if r := a*a + b*b; r > 9 {
+			t := 3 / math.Sqrt(r)
+			h.m[i], h.m[i+1] = t*a*s, t*b*s
+		}
+	}
+}
+
+// at evaluates the spline and is used as the TransitionFunc. NaN returns NaN
+// rather than searching for a segment that does not exist.
+func (h *hermite) at(completed float64) float64 {
+	last := len(h.x) - 1
+	if math.IsNaN(completed) {
+		return completed
+	}
+	if completed <= 0 {
+		return h.y[0]
+	}
+	if completed >= 1 {
+		return h.y[last]
+	}
+	i := sort.SearchFloat64s(h.x, completed)
+	if h.x[i] == completed {
+		return h.y[i]
+	}
+	return hermiteAt(completed, h.x[i-1], h.x[i], h.y[i-1], h.y[i], h.m[i-1], h.m[i])
+}
+
+// hermiteAt evaluates a cubic Hermite segment between (x0, y0) and (x1, y1)
+// with tangents m0 and m1.
+func hermiteAt(x, x0, x1, y0, y1, m0, m1 float64) float64 {
+	w := x1 - x0
+	t := (x - x0) / w
+	t2 := t * t
+	t3 := t2 * t
+	return (2*t3-3*t2+1)*y0 + (t3-2*t2+t)*w*m0 + (-2*t3+3*t2)*y1 + (t3-t2)*w*m1
+}
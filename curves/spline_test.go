@@ -0,0 +1,80 @@
+package curves_test
+
+import (
+	"math"
+
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Splines", func() {
+	samples := []Point{{X: 100, Y: 0}, {X: 150, Y: .6}, {X: 120, Y: .1}, {X: 200, Y: 1}, {X: 170, Y: 1}}
+
+	Describe("CatmullRom", func() {
+		It("should pass through every point", func() {
+			fn, err := CatmullRom(samples, 0)
+			Ω(err).Should(BeNil())
+			Ω(fn(0)).Should(Equal(0.))
+			Ω(fn(.2)).Should(BeNumerically("~", .1, 1e-12))
+			Ω(fn(.5)).Should(BeNumerically("~", .6, 1e-12))
+			Ω(fn(.7)).Should(BeNumerically("~", 1, 1e-12))
+			Ω(fn(1)).Should(Equal(1.))
+		})
+		It("should reproduce a straight line", func() {
+			fn, err := CatmullRom([]Point{{0, 0}, {.3, .3}, {1, 1}}, 0)
+			Ω(err).Should(BeNil())
+			for x := 0.; x <= 1; x += .1 {
+				Ω(fn(x)).Should(BeNumerically("~", x, 1e-12))
+			}
+		})
+	})
+	Describe("MonotoneCubic", func() {
+		It("should never overshoot monotonic samples", func() {
+			fn, err := MonotoneCubic(samples)
+			Ω(err).Should(BeNil())
+			prev := fn(0)
+			for x := 0.; x <= 1; x += .001 {
+				y := fn(x)
+				Ω(y).Should(BeNumerically(">=", prev))
+				Ω(y).Should(BeNumerically("<=", 1))
+				prev = y
+			}
+			Ω(fn(1)).Should(Equal(1.))
+		})
+	})
+	Describe("Hermite", func() {
+		It("should follow the provided tangents", func() {
+			fn, err := Hermite([]Point{{0, 0}, {1, 1}}, []float64{0, 0})
+			Ω(err).Should(BeNil())
+			for x := 0.; x <= 1; x += .1 {
+				Ω(fn(x)).Should(BeNumerically("~", 3*x*x-2*x*x*x, 1e-12))
+			}
+		})
+		It("should scale tangents with the points", func() {
+			fn, err := Hermite([]Point{{20, 1}, {0, 0}}, []float64{.05, .05})
+			Ω(err).Should(BeNil())
+			Ω(fn(.5)).Should(BeNumerically("~", .5, 1e-12))
+		})
+		It("should require a tangent for each point", func() {
+			_, err := Hermite(samples, []float64{0})
+			Ω(err).Should(Equal(ErrTangents))
+			_, err = Hermite([]Point{{0, 0}, {1, 1}}, []float64{0, math.NaN()})
+			Ω(err).Should(Equal(ErrTangents))
+		})
+	})
+	It("should validate points", func() {
+		_, err := CatmullRom([]Point{{0, 0}}, 0)
+		Ω(err).Should(Equal(ErrPoints))
+		_, err = MonotoneCubic([]Point{{0, 0}, {0, 1}})
+		Ω(err).Should(Equal(ErrPoints))
+		_, err = MonotoneCubic([]Point{{0, 0}, {1, math.Inf(1)}})
+		Ω(err).Should(Equal(ErrPoints))
+	})
+	It("should return NaN for NaN input", func() {
+		fn, err := MonotoneCubic(samples)
+		Ω(err).Should(BeNil())
+		Ω(math.IsNaN(fn(math.NaN()))).Should(BeTrue())
+	})
+})
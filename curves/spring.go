@@ -0,0 +1,122 @@
+package curves
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/gopackage/tween"
+)
+
+// ErrSpring is returned by Spring when the mass or stiffness is not positive
+// or the damping is negative.
+var ErrSpring = errors.New("curves: spring needs positive mass and stiffness and non-negative damping")
+
+// springRest is the distance from the end value where a spring is at rest.
+const springRest = 1e-3
+
+// springLimit is the longest a spring will be simulated before it is
+// considered at rest regardless of its position.
+const springLimit = 60.
+
+// Spring creates a transition that follows a damped spring moving from 0 to
+// 1, matching the CSS spring(mass, stiffness, damping, velocity) timing
+// function. The velocity is the initial velocity in units per second (e.g. a
+// release velocity from a gesture).
+//
+// The spring is simulated until it comes to rest and that time is stretched
+// to fit the tween duration. Use SpringDuration to set a tween duration that
+// matches the natural motion of the spring.
+func Spring(mass, stiffness, damping, velocity float64) (tween.TransitionFunc, error) {
+	s, err := newSpring(mass, stiffness, damping, velocity)
+	if err != nil {
+		return nil, err
+	}
+	settle := s.settle()
+	return func(completed float64) float64 {
+		if completed <= 0 {
+			return 0
+		}
+		if completed >= 1 {
+			return 1
+		}
+		u, _ := s.at(completed * settle)
+		return 1 + u
+	}, nil
+}
+
+// SpringDuration calculates how long a spring takes to come to rest.
+func SpringDuration(mass, stiffness, damping, velocity float64) (time.Duration, error) {
+	s, err := newSpring(mass, stiffness, damping, velocity)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(s.settle() * float64(time.Second)), nil
+}
+
+// spring is the analytic solution of a damped harmonic oscillator starting
+// one unit away from rest.
+type spring struct {
+	w0   float64 // w0 is the undamped angular frequency
+	zeta float64 // zeta is the damping ratio
+	v0   float64 // v0 is the initial velocity
+}
+
+func newSpring(mass, stiffness, damping, velocity float64) (*spring, error) {
+	if !(mass > 0) || !(stiffness > 0) || !(damping >= 0) || math.IsNaN(velocity) ||
+		math.IsInf(mass, 0) || math.IsInf(stiffness, 0) || math.IsInf(damping, 0) || math.IsInf(velocity, 0) {
+		return nil, ErrSpring
+	}
+	return &spring{
+		w0:   math.Sqrt(stiffness / mass),
+		zeta: damping / (2 * math.Sqrt(stiffness*mass)),
+		v0:   velocity,
+	}, nil
+}
+
+// at calculates the displacement from rest and the velocity after t seconds.
+func (s *spring) at(t float64) (u, v float64) {
+	const a = -1. // the initial displacement
+	switch {
+	case s.zeta < 1:
+		wd := s.w0 * math.Sqrt(1-s.zeta*s.zeta)
+		b := (s.v0 + s.zeta*s.w0*a) / wd
+		e := math.Exp(-s.zeta * s.w0 * t)
+		sin, cos := math.Sincos(wd * t)
+		u = e * (a*cos + b*sin)
+		v = e * ((b*wd-s.zeta*s.w0*a)*cos - (a*wd+s.zeta*s.w0*b)*sin)
+	case s.zeta == 1:
+		b := s.v0 + s.w0*a
+		e := math.Exp(-s.w0 * t)
+		u = e * (a + b*t)
+		v = e * (b - s.w0*(a+b*t))
+	default:
+		root := s.w0 * math.Sqrt(s.zeta*s.zeta-1)
+		r1, r2 := -s.zeta*s.w0+root, -s.zeta*s.w0-root
+		c2 := (s.v0 - r1*a) / (r2 - r1)
+		c1 := a - c2
+		e1, e2 := math.Exp(r1*t), math.Exp(r2*t)
+		u = c1*e1 + c2*e2
+		v = c1*r1*e1 + c2*r2*e2
+	}
+	return u, v
+}
+
+// settle finds the time in seconds after which the spring stays within
+// springRest of its end value.
+func (s *spring) settle() float64 {
+	const step = 1. / 1000
+	// Keep looking for at least a full oscillation after the last time the
+	// spring was out of bounds.
+	period := 2 * math.Pi / s.w0
+	last := 0.
+	for t := step; t < springLimit && t-last < math.Max(1, period); t += step {
+		if u, v := s.at(t); math.Abs(u) >= springRest || math.Abs(v) >= springRest {
+			last = t
+		}
+	}
+	if last == 0 {
+		return step
+	}
+	return math.Min(last, springLimit)
+}
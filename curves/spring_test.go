@@ -0,0 +1,58 @@
+package curves_test
+
+import (
+	"time"
+
+	. "github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Spring", func() {
+	It("should overshoot when underdamped", func() {
+		fn, err := Spring(1, 100, 10, 0)
+		Ω(err).Should(BeNil())
+		Ω(fn(0)).Should(Equal(0.))
+		Ω(fn(1)).Should(Equal(1.))
+		max := 0.
+		for x := 0.; x <= 1; x += .01 {
+			if y := fn(x); y > max {
+				max = y
+			}
+		}
+		Ω(max).Should(BeNumerically(">", 1))
+		Ω(fn(.99)).Should(BeNumerically("~", 1, 1e-3))
+	})
+	It("should not overshoot when critically or over damped", func() {
+		for _, damping := range []float64{20, 40} {
+			fn, err := Spring(1, 100, damping, 0)
+			Ω(err).Should(BeNil())
+			prev := 0.
+			for x := 0.; x <= 1; x += .01 {
+				y := fn(x)
+				Ω(y).Should(BeNumerically(">=", prev))
+				Ω(y).Should(BeNumerically("<=", 1))
+				prev = y
+			}
+		}
+	})
+	It("should start with the initial velocity", func() {
+		still, _ := Spring(1, 100, 10, 0)
+		moving, _ := Spring(1, 100, 10, 20)
+		Ω(moving(.01)).Should(BeNumerically(">", still(.01)))
+	})
+	It("should calculate the time to come to rest", func() {
+		d, err := SpringDuration(1, 100, 10, 0)
+		Ω(err).Should(BeNil())
+		Ω(d).Should(BeNumerically("~", 1750*time.Millisecond, 100*time.Millisecond))
+	})
+	It("should reject invalid springs", func() {
+		_, err := Spring(0, 100, 10, 0)
+		Ω(err).Should(Equal(ErrSpring))
+		_, err = SpringDuration(1, -1, 10, 0)
+		Ω(err).Should(Equal(ErrSpring))
+		_, err = Spring(1, 100, -1, 0)
+		Ω(err).Should(Equal(ErrSpring))
+	})
+})
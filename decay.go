@@ -0,0 +1,204 @@
+package tween
+
+import (
+	"math"
+	"time"
+)
+
+// Decay is an inertia animation for flick scrolling and momentum panning. The
+// value starts moving at Velocity and slows down with friction until it
+// stops, so it has no fixed end value or duration. A decay can come to rest
+// at the nearest of a list of Snap points, or bounce back from bounds.
+//
+// A decay runs on an ordinary Engine: To is the value it comes to rest at and
+// Transition maps Completed to the percentage of the way from From to To, so
+// any Updater that tweens between From and To can show it. A decay flicked
+// outwards from a bound comes to rest where it started, so there is no
+// distance for Transitioned to show the bounce with - use a PositionUpdater
+// to receive the value of the decay itself.
+type Decay struct {
+	From         float64       // From is the value the decay starts at.
+	Velocity     float64       // Velocity is the starting velocity in units per second.
+	TimeConstant time.Duration // TimeConstant is how long the velocity takes to fall by 63% (defaults to 325ms).
+	Rest         float64       // Rest is how close to its resting value the decay must be to stop (defaults to 0.5).
+	Snap         []float64     // Snap lists the values the decay may come to rest at, the one nearest its natural resting value is used.
+	Min          float64       // Min is the lower bound the decay bounces back from, used when Min < Max.
+	Max          float64       // Max is the upper bound the decay bounces back from, used when Min < Max.
+}
+
+// decayPlan is a decay worked out as an exponential glide to target,
+// optionally followed by a critically damped spring back to a bound.
+type decayPlan struct {
+	from     float64       // from is the starting value
+	target   float64       // target is the value the glide heads towards
+	tau      float64       // tau is the time constant in seconds
+	glide    time.Duration // glide is how long the glide lasts
+	bounce   bool          // bounce is true if the glide hits a bound
+	bound    float64       // bound is the value the bounce settles at
+	velocity float64       // velocity is the speed the glide hits the bound at
+	offset   float64       // offset is the displacement from the bound when the bounce starts
+	omega    float64       // omega is the stiffness of the bounce spring
+	duration time.Duration // duration is the total length of the decay
+}
+
+// plan works out where the decay comes to rest and how long it takes.
+func (d Decay) plan() decayPlan {
+	tau := d.TimeConstant.Seconds()
+	if tau <= 0 {
+		tau = .325
+	}
+	rest := d.Rest
+	if rest <= 0 {
+		rest = .5
+	}
+	p := decayPlan{from: d.From, tau: tau, omega: 2 / tau}
+	p.target = d.From + d.Velocity*tau
+	bounded := d.Min < d.Max
+	if len(d.Snap) > 0 {
+		// Glide to the nearest snap point instead of the natural rest
+		best := d.Snap[0]
+		for _, snap := range d.Snap[1:] {
+			if math.Abs(snap-p.target) < math.Abs(best-p.target) {
+				best = snap
+			}
+		}
+		p.target = best
+		if bounded {
+			p.target = math.Max(d.Min, math.Min(d.Max, p.target))
+		}
+	} else if bounded && (p.target < d.Min || p.target > d.Max) {
+		p.bounce = true
+		p.bound = math.Max(d.Min, math.Min(d.Max, p.target))
+	}
+
+	if !p.bounce {
+		p.glide = seconds(tau * math.Log(math.Max(1, math.Abs(p.target-d.From)/rest)))
+		p.duration = p.glide
+		return p
+	}
+
+	if d.From < d.Min || d.From > d.Max {
+		// Already out of bounds, spring straight back
+		p.offset = d.From - p.bound
+		p.velocity = d.Velocity
+	} else {
+		// Glide until the bound is hit
+		remaining := 1 - (p.bound-d.From)/(p.target-d.From)
+		p.glide = seconds(-tau * math.Log(remaining))
+		p.velocity = d.Velocity * remaining
+	}
+	// Settle when the spring is close to the bound and barely moving
+	var t float64
+	for step := .001; t < 60; t += step {
+		x, v := p.spring(t)
+		if math.Abs(x) < rest && math.Abs(v)/p.omega < rest {
+			break
+		}
+	}
+	p.duration = p.glide + seconds(t)
+	return p
+}
+
+// spring calculates the displacement from the bound and velocity of the
+// bounce t seconds after it starts.
+func (p decayPlan) spring(t float64) (x, v float64) {
+	b := p.velocity + p.omega*p.offset
+	e := math.Exp(-p.omega * t)
+	return (p.offset + b*t) * e, (b - p.omega*(p.offset+b*t)) * e
+}
+
+// at calculates the value t after the decay starts.
+func (p decayPlan) at(t time.Duration) float64 {
+	if t >= p.duration {
+		return p.end()
+	}
+	if t < p.glide || !p.bounce {
+		return p.from + (p.target-p.from)*(1-math.Exp(-t.Seconds()/p.tau))
+	}
+	x, _ := p.spring((t - p.glide).Seconds())
+	return p.bound + x
+}
+
+// end is the value the decay comes to rest at.
+func (p decayPlan) end() float64 {
+	if p.bounce {
+		return p.bound
+	}
+	return p.target
+}
+
+// seconds converts seconds to a duration.
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// To calculates the value the decay comes to rest at.
+func (d Decay) To() float64 {
+	return d.plan().end()
+}
+
+// Duration calculates how long the decay takes to come to rest.
+func (d Decay) Duration() time.Duration {
+	return d.plan().duration
+}
+
+// Value calculates the value of the decay elapsed after it starts.
+func (d Decay) Value(elapsed time.Duration) float64 {
+	return d.plan().at(elapsed)
+}
+
+// Transition creates a transition from From to To that follows the decay.
+// A glide that doesn't end exactly at rest is stretched slightly so the
+// transition ends at exactly 1.
+func (d Decay) Transition() TransitionFunc {
+	p := d.plan()
+	distance := p.end() - p.from
+	if distance == 0 || p.duration <= 0 {
+		return func(completed float64) float64 {
+			return completed
+		}
+	}
+	glide := 1.
+	if !p.bounce {
+		glide = 1 - math.Exp(-p.duration.Seconds()/p.tau)
+	}
+	return func(completed float64) float64 {
+		if completed >= 1 {
+			return 1
+		}
+		if !p.bounce {
+			return (1 - math.Exp(-completed*p.duration.Seconds()/p.tau)) / glide
+		}
+		return (p.at(time.Duration(completed*float64(p.duration))) - p.from) / distance
+	}
+}
+
+// Engine creates an Engine that runs the decay with updater. The Engine
+// lasts at least one frame, even if the decay is already at rest. When
+// updater is a PositionUpdater, UpdatePosition is called with the value of
+// the decay instead of Update.
+func (d Decay) Engine(updater Updater) *Engine {
+	p := d.plan()
+	engine := NewEngine(p.duration, d.Transition(), updater)
+	if min := engine.FrameTime(); p.duration < min {
+		engine.Duration = min
+	}
+	if u, ok := updater.(PositionUpdater); ok {
+		engine.Updater = &decayUpdater{PositionUpdater: u, plan: p, duration: engine.Duration}
+	}
+	return engine
+}
+
+// decayUpdater sends the value of a decay to a PositionUpdater.
+type decayUpdater struct {
+	PositionUpdater
+	plan     decayPlan
+	duration time.Duration // duration is the length of the Engine
+	position [1]float64
+}
+
+// Update sends the value of the decay at the frame to UpdatePosition.
+func (u *decayUpdater) Update(frame Frame) {
+	u.position[0] = u.plan.at(time.Duration(frame.Completed * float64(u.duration)))
+	u.UpdatePosition(frame, u.position[:])
+}
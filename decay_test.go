@@ -0,0 +1,118 @@
+package tween_test
+
+import (
+	"math"
+	"time"
+
+	. "github.com/gopackage/tween"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Decay", func() {
+	It("should glide to a stop", func() {
+		decay := Decay{From: 10, Velocity: 1000}
+		Ω(decay.To()).Should(BeNumerically("~", 335, 1e-9))
+		// The remaining distance falls to half a unit
+		Ω(decay.Duration().Seconds()).Should(BeNumerically("~", .325*math.Log(650), 1e-6))
+		Ω(decay.Value(0)).Should(Equal(10.))
+		Ω(decay.To() - decay.Value(decay.Duration())).Should(BeNumerically("<", .5))
+		prev := 10.
+		for t := time.Duration(0); t < decay.Duration(); t += 10 * time.Millisecond {
+			v := decay.Value(t)
+			Ω(v).Should(BeNumerically(">=", prev))
+			prev = v
+		}
+	})
+	It("should start with the release velocity", func() {
+		decay := Decay{Velocity: -500, TimeConstant: time.Second}
+		Ω(decay.To()).Should(Equal(-500.))
+		Ω(decay.Value(time.Millisecond) / .001).Should(BeNumerically("~", -500, 1))
+	})
+	It("should map the decay to a transition", func() {
+		decay := Decay{From: 10, Velocity: 1000}
+		fn := decay.Transition()
+		Ω(fn(0)).Should(Equal(0.))
+		Ω(fn(1)).Should(Equal(1.))
+		Ω(10 + 325*fn(.5)).Should(BeNumerically("~", decay.Value(decay.Duration()/2), .5))
+	})
+	It("should snap to the nearest stop", func() {
+		decay := Decay{Velocity: 1000, Snap: []float64{0, 300, 600}}
+		Ω(decay.To()).Should(Equal(300.))
+		Ω(decay.Transition()(1)).Should(Equal(1.))
+		decay = Decay{Velocity: 1000, Snap: []float64{0, 300, 600}, Min: 0, Max: 200}
+		Ω(decay.To()).Should(Equal(200.))
+	})
+	It("should bounce back from bounds", func() {
+		decay := Decay{Velocity: 1000, Min: -100, Max: 200}
+		Ω(decay.To()).Should(Equal(200.))
+		max := 0.
+		for t := time.Duration(0); t < decay.Duration(); t += time.Millisecond {
+			max = math.Max(max, decay.Value(t))
+		}
+		Ω(max).Should(BeNumerically(">", 210))
+		Ω(decay.Value(decay.Duration())).Should(Equal(200.))
+		Ω(decay.Value(decay.Duration() - time.Millisecond)).Should(BeNumerically("~", 200, 1))
+		fn := decay.Transition()
+		Ω(fn(1)).Should(Equal(1.))
+		Ω(fn(.5)).Should(BeNumerically(">", 1))
+	})
+	It("should spring back when out of bounds", func() {
+		decay := Decay{From: 250, Min: 0, Max: 200}
+		Ω(decay.To()).Should(Equal(200.))
+		prev := 250.
+		for t := time.Duration(0); t < decay.Duration(); t += 10 * time.Millisecond {
+			v := decay.Value(t)
+			Ω(v).Should(BeNumerically("<=", prev))
+			Ω(v).Should(BeNumerically(">=", 200))
+			prev = v
+		}
+	})
+	It("should bounce when flicked outwards at a bound", func() {
+		decay := Decay{From: 200, Velocity: 1000, Min: -100, Max: 200}
+		Ω(decay.To()).Should(Equal(200.))
+		Ω(decay.Duration()).Should(BeNumerically(">", 0))
+		max := 0.
+		for t := time.Duration(0); t < decay.Duration(); t += time.Millisecond {
+			max = math.Max(max, decay.Value(t))
+		}
+		Ω(max).Should(BeNumerically(">", 250))
+		Ω(decay.Value(decay.Duration())).Should(Equal(200.))
+	})
+	It("should send the bounce to a PositionUpdater", func(done Done) {
+		d := make(chan int)
+		positions := &Positions{Recorder: Recorder{Done: d}}
+		decay := Decay{From: 200, Velocity: 1000, Min: -100, Max: 200, TimeConstant: 50 * time.Millisecond}
+		run(decay.Engine(positions), d)
+		Ω(positions.Positions[0]).Should(Equal([]float64{200}))
+		Ω(positions.Positions[len(positions.Positions)-1]).Should(Equal([]float64{200}))
+		max := 0.
+		for _, position := range positions.Positions {
+			max = math.Max(max, position[0])
+		}
+		// The bounce peaks about 9 units past the bound
+		Ω(max).Should(BeNumerically(">", 205))
+		close(done)
+	}, 2)
+	It("should run on an engine", func(done Done) {
+		d := make(chan int)
+		recorder := &Recorder{Done: d}
+		decay := Decay{Velocity: 100, TimeConstant: 50 * time.Millisecond}
+		run(decay.Engine(recorder), d)
+		Ω(recorder.Running).Should(Equal(decay.Duration()))
+		last := recorder.Frames[len(recorder.Frames)-1]
+		Ω(last.Transitioned).Should(Equal(1.))
+		close(done)
+	}, 2)
+	It("should run a decay that is already at rest", func(done Done) {
+		d := make(chan int)
+		recorder := &Recorder{Done: d}
+		decay := Decay{From: 5}
+		Ω(decay.To()).Should(Equal(5.))
+		Ω(decay.Duration()).Should(Equal(time.Duration(0)))
+		run(decay.Engine(recorder), d)
+		Ω(recorder.Frames).Should(HaveLen(2))
+		close(done)
+	}, 2)
+})
@@ -0,0 +1,67 @@
+package tween
+
+import "time"
+
+// EventType identifies a change in the lifecycle of an Engine.
+type EventType int
+
+const (
+	// EventStarted is sent when the tween starts, before the initial frame.
+	EventStarted EventType = iota
+	// EventUpdated is sent after each frame is sent to the Updater.
+	EventUpdated
+	// EventPaused is sent when the tween is paused.
+	EventPaused
+	// EventResumed is sent when a paused tween continues.
+	EventResumed
+	// EventRepeated is sent when a repeating tween starts playing again.
+	EventRepeated
+	// EventCompleted is sent when the tween reaches the end, before the
+	// Updater ends.
+	EventCompleted
+	// EventInterrupted is sent when the tween is stopped early, before the
+	// Updater ends.
+	EventInterrupted
+)
+
+var eventNames = []string{"started", "updated", "paused", "resumed", "repeated", "completed", "interrupted"}
+
+// String names the event type.
+func (t EventType) String() string {
+	if t < 0 || int(t) >= len(eventNames) {
+		return "unknown"
+	}
+	return eventNames[t]
+}
+
+// Event describes a change in the lifecycle of an Engine.
+type Event struct {
+	Type  EventType // Type is the kind of change.
+	Time  time.Time // Time is when the change happened.
+	Frame Frame     // Frame is the most recent frame of the tween.
+	Cycle int       // Cycle counts how many times a repeating tween has played, starting at 0.
+}
+
+// Listen registers a listener that receives every lifecycle event of the
+// Engine, so other code can observe a tween without wrapping its Updater.
+// Listeners are called in order on the goroutine running the tween and
+// should return quickly - forward events to a channel for slow work.
+func (e *Engine) Listen(listener func(Event)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.listeners = append(e.listeners, listener)
+}
+
+// emit sends an event to the listeners.
+func (e *Engine) emit(t EventType, frame Frame, cycle int) {
+	e.mu.Lock()
+	listeners := e.listeners
+	e.mu.Unlock()
+	if len(listeners) == 0 {
+		return
+	}
+	event := Event{Type: t, Time: time.Now(), Frame: frame, Cycle: cycle}
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
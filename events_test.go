@@ -0,0 +1,134 @@
+package tween_test
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/gopackage/tween"
+	"github.com/gopackage/tween/curves"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// Listener records lifecycle events.
+type Listener struct {
+	sync.Mutex
+	Events []Event
+}
+
+func (l *Listener) Listen(event Event) {
+	l.Lock()
+	defer l.Unlock()
+	l.Events = append(l.Events, event)
+}
+
+// Types lists the types of the recorded events, leaving out updates.
+func (l *Listener) Types() []EventType {
+	l.Lock()
+	defer l.Unlock()
+	types := []EventType{}
+	for _, event := range l.Events {
+		if event.Type != EventUpdated {
+			types = append(types, event.Type)
+		}
+	}
+	return types
+}
+
+var _ = Describe("Events", func() {
+	It("should name event types", func() {
+		Ω(EventStarted.String()).Should(Equal("started"))
+		Ω(EventInterrupted.String()).Should(Equal("interrupted"))
+		Ω(EventType(99).String()).Should(Equal("unknown"))
+	})
+	It("should send an event for each frame", func(done Done) {
+		d := make(chan int)
+		recorder := &Recorder{Done: d}
+		listener := &Listener{}
+		engine := NewEngine(200*time.Millisecond, curves.Linear, recorder)
+		engine.Listen(listener.Listen)
+		run(engine, d)
+		Ω(listener.Types()).Should(Equal([]EventType{EventStarted, EventCompleted}))
+		Ω(listener.Events).Should(HaveLen(len(recorder.Frames) + 2))
+		for i, frame := range recorder.Frames {
+			Ω(listener.Events[i+1].Type).Should(Equal(EventUpdated))
+			Ω(listener.Events[i+1].Frame).Should(Equal(frame))
+		}
+		for i, event := range listener.Events[1:] {
+			Ω(event.Time).ShouldNot(BeTemporally("<", listener.Events[i].Time))
+		}
+		close(done)
+	}, 2)
+	It("should repeat", func(done Done) {
+		d := make(chan int)
+		recorder := &Recorder{Done: d}
+		listener := &Listener{}
+		engine := NewEngine(100*time.Millisecond, curves.Linear, recorder)
+		engine.Repeat = 2
+		engine.Listen(listener.Listen)
+		run(engine, d)
+		Ω(listener.Types()).Should(Equal([]EventType{EventStarted, EventRepeated, EventRepeated, EventCompleted}))
+		starts, ends := 0, 0
+		for _, frame := range recorder.Frames {
+			if frame.Index == 0 {
+				starts++
+			}
+			if frame.Completed == 1 {
+				ends++
+			}
+		}
+		Ω(starts).Should(Equal(3))
+		Ω(ends).Should(Equal(3))
+		Ω(listener.Events[len(listener.Events)-1].Cycle).Should(Equal(2))
+		close(done)
+	}, 2)
+	It("should pause and resume", func(done Done) {
+		d := make(chan int)
+		recorder := &Recorder{Done: d}
+		listener := &Listener{}
+		engine := NewEngine(200*time.Millisecond, curves.Linear, recorder)
+		engine.Listen(listener.Listen)
+		engine.Start()
+		time.Sleep(50 * time.Millisecond)
+		engine.Pause()
+		engine.Pause()
+		time.Sleep(200 * time.Millisecond)
+		engine.Resume()
+		<-d
+		Ω(listener.Types()).Should(Equal([]EventType{EventStarted, EventPaused, EventResumed, EventCompleted}))
+		var paused, resumed Event
+		for _, event := range listener.Events {
+			switch event.Type {
+			case EventPaused:
+				paused = event
+			case EventResumed:
+				resumed = event
+			}
+		}
+		Ω(resumed.Time.Sub(paused.Time)).Should(BeNumerically("~", 200*time.Millisecond, 50*time.Millisecond))
+		Ω(resumed.Frame).Should(Equal(paused.Frame))
+		Ω(paused.Frame.Completed).Should(BeNumerically("~", .25, .1))
+		// The clock stops while paused so no frames are skipped
+		for _, frame := range recorder.Frames {
+			Ω(frame.Skipped).Should(Equal(0))
+		}
+		close(done)
+	}, 2)
+	It("should report interrupted tweens", func(done Done) {
+		d := make(chan int)
+		recorder := &Recorder{Done: d}
+		listener := &Listener{}
+		engine := NewEngine(time.Second, curves.Linear, recorder)
+		engine.Repeat = -1
+		engine.Listen(listener.Listen)
+		engine.Start()
+		time.Sleep(50 * time.Millisecond)
+		engine.Pause()
+		time.Sleep(20 * time.Millisecond)
+		engine.Stop()
+		<-d
+		Ω(listener.Types()).Should(Equal([]EventType{EventStarted, EventPaused, EventInterrupted}))
+		close(done)
+	}, 2)
+})
@@ -0,0 +1,74 @@
+package plot
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gopackage/tween/curves"
+)
+
+// Format is an image file format.
+type Format string
+
+// Supported image formats.
+const (
+	FormatPNG Format = "png"
+	FormatSVG Format = "svg"
+)
+
+// Write plots the curves in the requested format.
+func Write(w io.Writer, format Format, series []Series, opts Options) error {
+	switch format {
+	case FormatPNG:
+		return PNG(w, series, opts)
+	case FormatSVG:
+		return SVG(w, series, opts)
+	}
+	return fmt.Errorf("plot: unknown format %q", format)
+}
+
+// Gallery plots every registered curve (see curves.Names) into its own image
+// file in dir and writes an index.html page showing them all.
+func Gallery(dir string, format Format, opts Options) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	index, err := os.Create(filepath.Join(dir, "index.html"))
+	if err != nil {
+		return err
+	}
+	defer index.Close()
+	out := bufio.NewWriter(index)
+	out.WriteString("<html><head><style>body {margin:1em;}\n.box { position: relative; border: 1px solid #ddd; margin: .5em; display: inline-block; }\n.box h1 { font-size: small; font-weight: normal; margin: .5em; }</style><title>Curves</title></head><body>\n")
+	for _, name := range curves.Names() {
+		fn, _ := curves.Lookup(name)
+		file := strings.NewReplacer("/", "_", "\\", "_").Replace(name) + "." + string(format)
+		if err := writeFile(filepath.Join(dir, file), format, []Series{{name, fn}}, opts); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "<div class=\"box\"><h1>%s</h1><img src=\"%s\"></div>\n", html.EscapeString(name), html.EscapeString(url.PathEscape(file)))
+	}
+	out.WriteString("</body></html>\n")
+	if err := out.Flush(); err != nil {
+		return err
+	}
+	return index.Close()
+}
+
+func writeFile(path string, format Format, series []Series, opts Options) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := Write(f, format, series, opts); err != nil {
+		return err
+	}
+	return f.Close()
+}
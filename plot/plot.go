@@ -0,0 +1,122 @@
+// Package plot draws transition curves as PNG or SVG images so easing
+// choices can be reviewed visually.
+package plot
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/gopackage/tween"
+)
+
+// Series is a named transition curve to plot.
+type Series struct {
+	Name string               // Name labels the curve in the legend.
+	Func tween.TransitionFunc // Func is the curve to plot.
+}
+
+// Options controls how curves are plotted.
+type Options struct {
+	Width   int  // Width of the image in pixels (defaults to 300).
+	Height  int  // Height of the image in pixels (defaults to 300).
+	NoShade bool // NoShade leaves the regions below 0 and above 1 where curves overshoot unshaded.
+	Trail   int  // Trail is the number of motion trail dots drawn for each curve (0 for none).
+}
+
+// DefaultOptions holds the default Width and Height used for the options
+// that are not set.
+var DefaultOptions = Options{Width: 300, Height: 300}
+
+// withDefaults fills in the options that are not set.
+func (o Options) withDefaults() Options {
+	if o.Width <= 0 {
+		o.Width = DefaultOptions.Width
+	}
+	if o.Height <= 0 {
+		o.Height = DefaultOptions.Height
+	}
+	return o
+}
+
+// Palette is the sequence of colors used for each plotted curve.
+var Palette = []color.RGBA{
+	{0x66, 0x00, 0x00, 0xff},
+	{0x1f, 0x77, 0xb4, 0xff},
+	{0x2c, 0xa0, 0x2c, 0xff},
+	{0xff, 0x7f, 0x0e, 0xff},
+	{0x94, 0x67, 0xbd, 0xff},
+	{0x8c, 0x56, 0x4b, 0xff},
+}
+
+var (
+	axisColor   = color.RGBA{0x00, 0x00, 0x00, 0xff}
+	guideColor  = color.RGBA{0xcc, 0xcc, 0xcc, 0xff}
+	shadeColor  = color.RGBA{0xff, 0xe5, 0xe5, 0xff}
+	trackColor  = color.RGBA{0xee, 0xee, 0xee, 0xff}
+	background  = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	trailRadius = 3
+)
+
+// layout maps curve values onto image coordinates.
+type layout struct {
+	width, height int
+	left, right   int     // left and right edges of the plot area
+	top, bottom   int     // top and bottom edges of the plot area
+	min, max      float64 // min and max values shown on the vertical axis
+	trail         int     // trail is the x position of the first motion trail track
+}
+
+// newLayout sizes the plot area so every curve fits, always showing the
+// range 0 - 1, and leaves room on the right for motion trails. The options
+// must already have their defaults applied.
+func newLayout(series []Series, opts Options) *layout {
+	l := &layout{width: opts.Width, height: opts.Height, min: 0, max: 1}
+	for _, s := range series {
+		for i := 0; i <= l.width; i++ {
+			y := s.Func(float64(i) / float64(l.width))
+			if math.IsNaN(y) || math.IsInf(y, 0) {
+				continue
+			}
+			l.min = math.Min(l.min, y)
+			l.max = math.Max(l.max, y)
+		}
+	}
+	margin := opts.Width / 6
+	l.left, l.right = margin, opts.Width-margin
+	l.top, l.bottom = opts.Height/6, opts.Height-opts.Height/6
+	if opts.Trail > 0 {
+		l.trail = l.right + margin/3
+	}
+	return l
+}
+
+// x converts a completed value to a horizontal position.
+func (l *layout) x(completed float64) float64 {
+	return float64(l.left) + completed*float64(l.right-l.left)
+}
+
+// y converts a transitioned value to a vertical position.
+func (l *layout) y(transitioned float64) float64 {
+	return float64(l.bottom) - (transitioned-l.min)/(l.max-l.min)*float64(l.bottom-l.top)
+}
+
+// trailX is the horizontal position of the motion trail of the i-th series,
+// kept far enough inside the image for the trail dots to fit.
+func (l *layout) trailX(i int) int {
+	x := l.trail + i*(2*trailRadius+2)
+	if x > l.width-1-trailRadius {
+		x = l.width - 1 - trailRadius
+	}
+	return x
+}
+
+// trailY is the vertical position of a motion trail dot, kept far enough
+// inside the image for the dot to fit.
+func (l *layout) trailY(transitioned float64) float64 {
+	return math.Max(float64(trailRadius), math.Min(float64(l.height-1-trailRadius), l.y(transitioned)))
+}
+
+// colorOf picks the palette color for the i-th series.
+func colorOf(i int) color.RGBA {
+	return Palette[i%len(Palette)]
+}
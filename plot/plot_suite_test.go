@@ -0,0 +1,13 @@
+package plot_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPlot(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plot Suite")
+}
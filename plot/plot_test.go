@@ -0,0 +1,103 @@
+package plot_test
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/gopackage/tween/curves"
+	. "github.com/gopackage/tween/plot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plot", func() {
+	series := []Series{{"easeOutBack", curves.EaseOutBack}, {"linear", curves.Linear}}
+
+	It("should draw curves onto an image", func() {
+		img := Image(series, Options{Width: 200, Height: 100, Trail: 5})
+		Ω(img.Bounds().Dx()).Should(Equal(200))
+		Ω(img.Bounds().Dy()).Should(Equal(100))
+		// The first curve is drawn in the first palette color
+		found := false
+		for y := 0; y < 100 && !found; y++ {
+			for x := 0; x < 200 && !found; x++ {
+				found = img.RGBAAt(x, y) == Palette[0]
+			}
+		}
+		Ω(found).Should(BeTrue())
+		// Overshoot is shaded above the end value
+		Ω(img.RGBAAt(100, 100/6+1)).ShouldNot(Equal(color.RGBA{0xff, 0xff, 0xff, 0xff}))
+	})
+	It("should encode PNG images", func() {
+		out := bytes.Buffer{}
+		Ω(PNG(&out, series, Options{})).Should(Succeed())
+		img, err := png.Decode(&out)
+		Ω(err).Should(BeNil())
+		Ω(img.Bounds().Dx()).Should(Equal(DefaultOptions.Width))
+	})
+	It("should apply the default options to a zero Options", func() {
+		img := Image(series, Options{})
+		Ω(img.Bounds().Dx()).Should(Equal(DefaultOptions.Width))
+		Ω(img.Bounds().Dy()).Should(Equal(DefaultOptions.Height))
+		// Shading is on by default
+		Ω(img.RGBAAt(DefaultOptions.Width/2, DefaultOptions.Height/6+1)).ShouldNot(Equal(color.RGBA{0xff, 0xff, 0xff, 0xff}))
+		// Setting other options keeps the shading
+		img = Image(series, Options{Width: 600})
+		Ω(img.Bounds().Dy()).Should(Equal(DefaultOptions.Height))
+		Ω(img.RGBAAt(300, DefaultOptions.Height/6+1)).ShouldNot(Equal(color.RGBA{0xff, 0xff, 0xff, 0xff}))
+		img = Image(series, Options{NoShade: true})
+		Ω(img.RGBAAt(DefaultOptions.Width/6+5, DefaultOptions.Height/6+1)).Should(Equal(color.RGBA{0xff, 0xff, 0xff, 0xff}))
+	})
+	It("should keep motion trails inside the image", func() {
+		many := []Series{}
+		for _, name := range curves.Names() {
+			fn, _ := curves.Lookup(name)
+			many = append(many, Series{Name: name, Func: fn})
+		}
+		out := bytes.Buffer{}
+		Ω(SVG(&out, many, Options{Width: 100, Height: 100, Trail: 4})).Should(Succeed())
+		circles := regexp.MustCompile(`<circle cx="(\d+)" cy="([\d.]+)" r="(\d+)"`).FindAllStringSubmatch(out.String(), -1)
+		Ω(circles).ShouldNot(BeEmpty())
+		for _, c := range circles {
+			x, _ := strconv.ParseFloat(c[1], 64)
+			y, _ := strconv.ParseFloat(c[2], 64)
+			r, _ := strconv.ParseFloat(c[3], 64)
+			Ω(x - r).Should(BeNumerically(">=", 0))
+			Ω(x + r).Should(BeNumerically("<", 100))
+			Ω(y - r).Should(BeNumerically(">=", 0))
+			Ω(y + r).Should(BeNumerically("<", 100))
+		}
+	})
+	It("should write SVG images", func() {
+		out := bytes.Buffer{}
+		Ω(SVG(&out, series, Options{Trail: 3})).Should(Succeed())
+		svg := out.String()
+		Ω(svg).Should(HavePrefix("<svg"))
+		Ω(svg).Should(ContainSubstring("easeOutBack"))
+		Ω(svg).Should(ContainSubstring("<circle"))
+		Ω(svg).Should(HaveSuffix("</svg>\n"))
+	})
+	It("should reject unknown formats", func() {
+		Ω(Write(&bytes.Buffer{}, Format("gif"), series, Options{})).ShouldNot(Succeed())
+	})
+	It("should generate a gallery of every curve", func() {
+		dir, err := ioutil.TempDir("", "gallery")
+		Ω(err).Should(BeNil())
+		defer os.RemoveAll(dir)
+		Ω(Gallery(dir, FormatSVG, Options{})).Should(Succeed())
+		for _, name := range []string{"index.html", "easeInOutElastic.svg", "ease-in.svg"} {
+			_, err := os.Stat(filepath.Join(dir, name))
+			Ω(err).Should(BeNil())
+		}
+		index, err := ioutil.ReadFile(filepath.Join(dir, "index.html"))
+		Ω(err).Should(BeNil())
+		Ω(string(index)).Should(ContainSubstring("easeInOutBounce.svg"))
+	})
+})
@@ -0,0 +1,93 @@
+package plot
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+)
+
+// Image draws the curves onto a new image.
+func Image(series []Series, opts Options) *image.RGBA {
+	opts = opts.withDefaults()
+	l := newLayout(series, opts)
+	img := image.NewRGBA(image.Rect(0, 0, l.width, l.height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{background}, image.Point{}, draw.Src)
+
+	y0, y1 := int(math.Round(l.y(0))), int(math.Round(l.y(1)))
+	if !opts.NoShade {
+		// Overshoot regions above 1 and below 0
+		draw.Draw(img, image.Rect(l.left, l.top, l.right+1, y1), &image.Uniform{shadeColor}, image.Point{}, draw.Src)
+		draw.Draw(img, image.Rect(l.left, y0+1, l.right+1, l.bottom+1), &image.Uniform{shadeColor}, image.Point{}, draw.Src)
+	}
+	// Guides at the end value and end time, then the axes
+	line(img, float64(l.left), float64(y1), float64(l.right), float64(y1), guideColor)
+	line(img, float64(l.right), float64(y0), float64(l.right), float64(y1), guideColor)
+	line(img, float64(l.left), float64(y0), float64(l.right), float64(y0), axisColor)
+	line(img, float64(l.left), float64(l.top), float64(l.left), float64(l.bottom), axisColor)
+
+	for i, s := range series {
+		c := colorOf(i)
+		px, py := l.x(0), l.y(s.Func(0))
+		for step := 1; step <= l.right-l.left; step++ {
+			completed := float64(step) / float64(l.right-l.left)
+			x, y := l.x(completed), l.y(s.Func(completed))
+			line(img, px, py, x, y, c)
+			px, py = x, y
+		}
+		if opts.Trail > 0 {
+			tx := float64(l.trailX(i))
+			line(img, tx, float64(l.top), tx, float64(l.bottom), trackColor)
+		}
+		for dot := 0; dot <= opts.Trail && opts.Trail > 0; dot++ {
+			completed := float64(dot) / float64(opts.Trail)
+			faded := c
+			faded.A = uint8(64 + 191*completed)
+			circle(img, float64(l.trailX(i)), l.trailY(s.Func(completed)), trailRadius, faded)
+		}
+	}
+	return img
+}
+
+// PNG writes the curves as a PNG image.
+func PNG(w io.Writer, series []Series, opts Options) error {
+	return png.Encode(w, Image(series, opts))
+}
+
+// line draws a line between two points by stepping along the longest axis.
+func line(img *image.RGBA, x0, y0, x1, y1 float64, c color.RGBA) {
+	steps := math.Max(math.Abs(x1-x0), math.Abs(y1-y0))
+	if steps < 1 {
+		steps = 1
+	}
+	for i := 0.; i <= steps; i++ {
+		blend(img, int(math.Round(x0+(x1-x0)*i/steps)), int(math.Round(y0+(y1-y0)*i/steps)), c)
+	}
+}
+
+// circle draws a filled circle.
+func circle(img *image.RGBA, cx, cy float64, r int, c color.RGBA) {
+	x0, y0 := int(math.Round(cx)), int(math.Round(cy))
+	for y := -r; y <= r; y++ {
+		for x := -r; x <= r; x++ {
+			if x*x+y*y <= r*r {
+				blend(img, x0+x, y0+y, c)
+			}
+		}
+	}
+}
+
+// blend draws a pixel over the existing image using the alpha of c.
+func blend(img *image.RGBA, x, y int, c color.RGBA) {
+	if !(image.Point{x, y}.In(img.Rect)) {
+		return
+	}
+	under := img.RGBAAt(x, y)
+	a := uint32(c.A)
+	mix := func(over, under uint8) uint8 {
+		return uint8((uint32(over)*a + uint32(under)*(255-a)) / 255)
+	}
+	img.SetRGBA(x, y, color.RGBA{mix(c.R, under.R), mix(c.G, under.G), mix(c.B, under.B), 0xff})
+}
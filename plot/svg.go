@@ -0,0 +1,59 @@
+package plot
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"image/color"
+	"io"
+)
+
+// SVG writes the curves as an SVG image with labelled axes and a legend.
+func SVG(w io.Writer, series []Series, opts Options) error {
+	opts = opts.withDefaults()
+	l := newLayout(series, opts)
+	out := bufio.NewWriter(w)
+	y0, y1 := l.y(0), l.y(1)
+
+	fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="10">`+"\n",
+		l.width, l.height, l.width, l.height)
+	fmt.Fprintf(out, `<rect width="%d" height="%d" fill="%s"/>`+"\n", l.width, l.height, hex(background))
+	if !opts.NoShade {
+		fmt.Fprintf(out, `<rect x="%d" y="%d" width="%d" height="%.1f" fill="%s"/>`+"\n", l.left, l.top, l.right-l.left, y1-float64(l.top), hex(shadeColor))
+		fmt.Fprintf(out, `<rect x="%d" y="%.1f" width="%d" height="%.1f" fill="%s"/>`+"\n", l.left, y0, l.right-l.left, float64(l.bottom)-y0, hex(shadeColor))
+	}
+	fmt.Fprintf(out, `<path d="M%d,%.1f L%d,%.1f L%d,%.1f" style="stroke:%s; fill:none;"/>`+"\n", l.left, y1, l.right, y1, l.right, y0, hex(guideColor))
+	fmt.Fprintf(out, `<path d="M%d,%.1f L%d,%.1f M%d,%d L%d,%d" style="stroke:%s; fill:none;"/>`+"\n", l.left, y0, l.right, y0, l.left, l.top, l.left, l.bottom, hex(axisColor))
+	// Axis labels
+	fmt.Fprintf(out, `<text x="%d" y="%.1f" text-anchor="end" dy="3">0</text>`+"\n", l.left-4, y0)
+	fmt.Fprintf(out, `<text x="%d" y="%.1f" text-anchor="end" dy="3">1</text>`+"\n", l.left-4, y1)
+	fmt.Fprintf(out, `<text x="%d" y="%d" text-anchor="middle">1</text>`+"\n", l.right, l.bottom+12)
+	fmt.Fprintf(out, `<text x="%d" y="%d" text-anchor="middle">completed</text>`+"\n", (l.left+l.right)/2, l.bottom+24)
+	fmt.Fprintf(out, `<text transform="translate(%d %d) rotate(-90)" text-anchor="middle">transitioned</text>`+"\n", l.left-16, (l.top+l.bottom)/2)
+
+	for i, s := range series {
+		c := hex(colorOf(i))
+		fmt.Fprintf(out, `<path d="M%.1f,%.1f`, l.x(0), l.y(s.Func(0)))
+		for step := 1; step <= l.right-l.left; step++ {
+			completed := float64(step) / float64(l.right-l.left)
+			fmt.Fprintf(out, ` L%.1f,%.1f`, l.x(completed), l.y(s.Func(completed)))
+		}
+		fmt.Fprintf(out, `" style="stroke:%s; fill:none;"/>`+"\n", c)
+		if opts.Trail > 0 {
+			fmt.Fprintf(out, `<path d="M%d,%d L%d,%d" style="stroke:%s; fill:none;"/>`+"\n", l.trailX(i), l.top, l.trailX(i), l.bottom, hex(trackColor))
+		}
+		for dot := 0; dot <= opts.Trail && opts.Trail > 0; dot++ {
+			completed := float64(dot) / float64(opts.Trail)
+			fmt.Fprintf(out, `<circle cx="%d" cy="%.1f" r="%d" fill="%s" fill-opacity="%.2f"/>`+"\n",
+				l.trailX(i), l.trailY(s.Func(completed)), trailRadius, c, .25+.75*completed)
+		}
+		fmt.Fprintf(out, `<text x="%d" y="%d" fill="%s">%s</text>`+"\n", l.left, l.top-6-12*(len(series)-1-i), c, html.EscapeString(s.Name))
+	}
+	fmt.Fprintln(out, `</svg>`)
+	return out.Flush()
+}
+
+// hex formats a color for SVG.
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
@@ -0,0 +1,341 @@
+package tween
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrSpringEngine is returned by SpringEngine.Validate when the spring
+// can't run until it settles.
+var ErrSpringEngine = errors.New("tween: invalid SpringEngine")
+
+// springStep is the longest time step of the spring simulation, short
+// enough to keep stiff springs stable.
+const springStep = time.Millisecond
+
+// springLimit is the longest a spring is predicted to move before it is
+// considered settled.
+const springLimit = time.Minute
+
+// PositionUpdater is an Updater that receives the position of every
+// dimension of a SpringEngine. The SpringEngine calls UpdatePosition instead
+// of Update when its Updater implements it.
+type PositionUpdater interface {
+	Updater
+	// UpdatePosition receives the current frame and the position of each
+	// dimension. position must not be kept after UpdatePosition returns.
+	UpdatePosition(frame Frame, position []float64)
+}
+
+// NewSpringEngine creates a SpringEngine with a framerate of 60fps and a
+// spring with a mass of 1, stiffness of 100 and damping of 10.
+func NewSpringEngine(from, to []float64, updater Updater) *SpringEngine {
+	return &SpringEngine{
+		Mass:      1,
+		Stiffness: 100,
+		Damping:   10,
+		From:      from,
+		To:        to,
+		Framerate: 60,
+		Updater:   updater,
+	}
+}
+
+// SpringEngine runs a physics spring until it settles, rather than for a
+// fixed duration like Engine. Each dimension of the value is pulled towards
+// To by the same spring, so a 2D or 3D point moves as one. The target can be
+// changed with Retarget while the spring is moving and the spring carries
+// on from its current position and velocity.
+//
+// Frames work as they do for Engine: Transitioned is how far the spring has
+// moved along the line from From to To as they were when it started, so it
+// goes below 0 or above 1 when the spring is retargeted off that line, and
+// Completed is the fraction of the predicted time to settle. The final frame
+// is sent once the spring settles with Completed set to exactly 1, which no
+// earlier frame reaches, and Transitioned set to exactly 1 unless the spring
+// was retargeted.
+type SpringEngine struct {
+	Mass      float64   // Mass of the object on the spring.
+	Stiffness float64   // Stiffness of the spring.
+	Damping   float64   // Damping slows the spring down, it must be positive for the spring to settle.
+	From      []float64 // From is the starting position of each dimension.
+	To        []float64 // To is the target position of each dimension.
+	Velocity  []float64 // Velocity is the starting velocity of each dimension in units per second, zero if not set.
+	RestDelta float64   // RestDelta is how close to its target each dimension must be to settle (defaults to 0.001).
+	RestSpeed float64   // RestSpeed is how slow each dimension must be moving to settle (defaults to 0.001).
+	Framerate int       // The number of frames per second (defaults to 60 fps).
+	Rate      float64   // Rate is the number of frames per second for non-integer rates, overriding Framerate when set.
+	Updater   Updater   // Updater updates the values for each frame.
+
+	mu      sync.Mutex // mu guards the fields below
+	running bool       // True if the spring is running
+	done    chan int   // Internal channel used to terminate the spring early
+	target  []float64  // target is a new target waiting for the next frame
+}
+
+// springState is the position and velocity of a simulated spring.
+type springState struct {
+	e        *SpringEngine
+	position []float64
+	velocity []float64
+	target   []float64
+	from     []float64 // from is where the spring started
+	to       []float64 // to is the target the spring started with
+}
+
+// Validate checks the spring can run until it settles: From, To and
+// Velocity (when set) must have the same length and Mass, Stiffness and
+// Damping must be positive and finite. Validate returns an error wrapping
+// ErrSpringEngine if not.
+func (s *SpringEngine) Validate() error {
+	if len(s.From) != len(s.To) || (len(s.Velocity) != 0 && len(s.Velocity) != len(s.To)) {
+		return fmt.Errorf("%w: From, To and Velocity need the same length", ErrSpringEngine)
+	}
+	for _, p := range []struct {
+		name  string
+		value float64
+	}{{"Mass", s.Mass}, {"Stiffness", s.Stiffness}, {"Damping", s.Damping}} {
+		if !(p.value > 0) || math.IsInf(p.value, 0) {
+			return fmt.Errorf("%w: %s must be positive, not %v", ErrSpringEngine, p.name, p.value)
+		}
+	}
+	return nil
+}
+
+// state creates the starting state of the spring.
+func (s *SpringEngine) state() *springState {
+	if err := s.Validate(); err != nil {
+		panic(err)
+	}
+	st := &springState{
+		e:        s,
+		position: append([]float64(nil), s.From...),
+		velocity: make([]float64, len(s.To)),
+		target:   append([]float64(nil), s.To...),
+		from:     append([]float64(nil), s.From...),
+		to:       append([]float64(nil), s.To...),
+	}
+	copy(st.velocity, s.Velocity)
+	return st
+}
+
+// step advances the spring by d using semi-implicit Euler integration.
+func (st *springState) step(d time.Duration) {
+	for d > 0 {
+		dt := springStep
+		if d < dt {
+			dt = d
+		}
+		d -= dt
+		h := dt.Seconds()
+		for i := range st.position {
+			force := -st.e.Stiffness*(st.position[i]-st.target[i]) - st.e.Damping*st.velocity[i]
+			st.velocity[i] += force / st.e.Mass * h
+			st.position[i] += st.velocity[i] * h
+		}
+	}
+}
+
+// settled reports if every dimension is close to its target and barely
+// moving.
+func (st *springState) settled() bool {
+	delta, speed := st.e.RestDelta, st.e.RestSpeed
+	if delta <= 0 {
+		delta = 1e-3
+	}
+	if speed <= 0 {
+		speed = 1e-3
+	}
+	for i := range st.position {
+		if math.Abs(st.position[i]-st.target[i]) > delta || math.Abs(st.velocity[i]) > speed {
+			return false
+		}
+	}
+	return true
+}
+
+// settle predicts how long the spring takes to settle from its current
+// state, in whole frames.
+func (st *springState) settle(frameTime time.Duration) time.Duration {
+	ahead := &springState{
+		e:        st.e,
+		position: append([]float64(nil), st.position...),
+		velocity: append([]float64(nil), st.velocity...),
+		target:   st.target,
+	}
+	var d time.Duration
+	for d < springLimit && !ahead.settled() {
+		ahead.step(frameTime)
+		d += frameTime
+	}
+	return d
+}
+
+// transitioned projects the position onto the line from where the spring
+// started to the target it started with, 0 at the start and 1 at the
+// target. It stays on the same scale when the spring is retargeted.
+func (st *springState) transitioned() float64 {
+	var along, length float64
+	for i := range st.position {
+		d := st.to[i] - st.from[i]
+		along += (st.position[i] - st.from[i]) * d
+		length += d * d
+	}
+	if length == 0 {
+		return 1
+	}
+	return along / length
+}
+
+// rate is the number of frames per second.
+func (s *SpringEngine) rate() float64 {
+	if s.Rate > 0 {
+		return s.Rate
+	}
+	return float64(s.Framerate)
+}
+
+// FrameTime calculates the duration of a single frame.
+func (s *SpringEngine) FrameTime() time.Duration {
+	return time.Duration(float64(time.Second) / s.rate())
+}
+
+// Settle predicts how long the spring takes to settle from From with
+// Velocity towards To. Settle panics if the spring is not valid.
+func (s *SpringEngine) Settle() time.Duration {
+	return s.state().settle(s.FrameTime())
+}
+
+// Start begins the spring running. Start panics with the error from
+// Validate if the spring is not valid, so check Validate first when the
+// spring parameters come from user input.
+func (s *SpringEngine) Start() {
+	st := s.state()
+	s.mu.Lock()
+	s.running = true
+	s.target = nil
+	s.done = make(chan int)
+	done := s.done
+	s.mu.Unlock()
+
+	go func() {
+		frameDuration := s.FrameTime()
+		predicted := st.settle(frameDuration)
+		frames := int(predicted / frameDuration)
+		if frames < 1 {
+			frames = 1
+		}
+
+		frame := Frame{}
+		// update sends the frame and positions to the updater
+		update := func() {
+			if u, ok := s.Updater.(PositionUpdater); ok {
+				u.UpdatePosition(frame, st.position)
+			} else {
+				s.Updater.Update(frame)
+			}
+		}
+
+		s.Updater.Start(int(math.Round(s.rate())), frames, frameDuration, predicted)
+
+		// Send initial frame
+		update()
+
+		started := time.Now()
+		timer := time.NewTimer(frameDuration)
+		defer timer.Stop()
+
+	loop:
+		for {
+			select {
+			case <-timer.C:
+				elapsed := time.Since(started)
+				due := int(elapsed / frameDuration)
+				if due <= frame.Index {
+					due = frame.Index + 1
+				}
+
+				// Simulate up to the due frame so the motion doesn't depend
+				// on when the ticks arrive
+				st.step(time.Duration(due-frame.Index) * frameDuration)
+				frame.Skipped = due - frame.Index - 1
+				frame.Index = due
+				frame.Elapsed = elapsed
+				frame.Jitter = elapsed - time.Duration(due)*frameDuration
+
+				s.mu.Lock()
+				target := s.target
+				s.target = nil
+				s.mu.Unlock()
+				if target != nil {
+					// Carry on from here towards the new target
+					st.target = target
+					predicted = frame.Elapsed + st.settle(frameDuration)
+				}
+
+				if st.settled() {
+					break loop
+				}
+				if frame.Elapsed >= predicted {
+					// Late ticks can outrun the prediction, only the final
+					// frame may reach Completed 1
+					predicted = frame.Elapsed + st.settle(frameDuration)
+				}
+				frame.Completed = float64(frame.Elapsed) / float64(predicted)
+				frame.Transitioned = st.transitioned()
+				update()
+				timer.Reset(time.Until(started.Add(time.Duration(frame.Index+1) * frameDuration)))
+			case <-done:
+				frame.Skipped = 0
+				frame.Jitter = 0
+				break loop
+			}
+		}
+		s.mu.Lock()
+		s.running = false
+		s.mu.Unlock()
+
+		// Send the final frame at rest on the target
+		copy(st.position, st.target)
+		for i := range st.velocity {
+			st.velocity[i] = 0
+		}
+		if frame.Index == 0 {
+			frame.Index = 1
+		}
+		frame.Completed = 1
+		frame.Transitioned = st.transitioned()
+		update()
+		s.Updater.End()
+	}()
+}
+
+// Retarget changes the target of a running spring from the next frame and
+// sets To. The spring keeps its position and velocity so it bends smoothly
+// towards the new target. Retarget panics if to has a different number of
+// dimensions and must not be called at the same time as Start.
+func (s *SpringEngine) Retarget(to ...float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(to) != len(s.To) {
+		panic("tween: SpringEngine Retarget needs a position for each dimension")
+	}
+	s.To = append([]float64(nil), to...)
+	if s.running {
+		s.target = append([]float64(nil), to...)
+	}
+}
+
+// Stop terminates the spring immediately, jumping to the target. Stop does
+// nothing if the spring is not running.
+func (s *SpringEngine) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		s.running = false
+		close(s.done)
+	}
+}
@@ -0,0 +1,154 @@
+package tween_test
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	. "github.com/gopackage/tween"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// Positions is a Recorder that also records spring positions.
+type Positions struct {
+	Recorder
+	Positions [][]float64
+}
+
+func (u *Positions) UpdatePosition(frame Frame, position []float64) {
+	u.Update(frame)
+	u.Positions = append(u.Positions, append([]float64(nil), position...))
+}
+
+var _ = Describe("SpringEngine", func() {
+	It("should run until the spring settles", func(done Done) {
+		d := make(chan int)
+		recorder := &Recorder{Done: d}
+		engine := NewSpringEngine([]float64{0}, []float64{10}, recorder)
+		settle := engine.Settle()
+		Ω(settle).Should(BeNumerically("~", 2100*time.Millisecond, 100*time.Millisecond))
+		started := time.Now()
+		run(engine, d)
+		Ω(time.Since(started)).Should(BeNumerically("~", settle, 100*time.Millisecond))
+		Ω(recorder.Running).Should(Equal(settle))
+		Ω(recorder.TotalFrames).Should(Equal(int(settle / engine.FrameTime())))
+		Ω(recorder.Frames[0]).Should(Equal(Frame{}))
+		last := recorder.Frames[len(recorder.Frames)-1]
+		Ω(last.Completed).Should(Equal(1.))
+		Ω(last.Transitioned).Should(Equal(1.))
+		max := 0.
+		for i, frame := range recorder.Frames[1:] {
+			Ω(frame.Index).Should(BeNumerically(">", recorder.Frames[i].Index))
+			max = math.Max(max, frame.Transitioned)
+		}
+		// Only the final frame is complete
+		for _, frame := range recorder.Frames[:len(recorder.Frames)-1] {
+			Ω(frame.Completed).Should(BeNumerically("<", 1))
+		}
+		// The default spring is underdamped
+		Ω(max).Should(BeNumerically(">", 1))
+		close(done)
+	}, 3)
+	It("should move every dimension together", func(done Done) {
+		d := make(chan int)
+		positions := &Positions{Recorder: Recorder{Done: d}}
+		engine := NewSpringEngine([]float64{0, 10}, []float64{100, 60}, positions)
+		engine.Stiffness = 400
+		engine.Damping = 40
+		run(engine, d)
+		Ω(positions.Positions[0]).Should(Equal([]float64{0, 10}))
+		for i, position := range positions.Positions {
+			Ω(position[1]).Should(BeNumerically("~", 10+position[0]/2, 1e-9))
+			Ω(position[0]).Should(BeNumerically("~", 100*positions.Frames[i].Transitioned, 1e-9))
+		}
+		Ω(positions.Positions[len(positions.Positions)-1]).Should(Equal([]float64{100, 60}))
+		close(done)
+	}, 2)
+	It("should keep moving smoothly when retargeted", func(done Done) {
+		d := make(chan int)
+		positions := &Positions{Recorder: Recorder{Done: d}}
+		engine := NewSpringEngine([]float64{0}, []float64{100}, positions)
+		engine.Damping = 20
+		engine.Start()
+		time.Sleep(150 * time.Millisecond)
+		engine.Retarget(-50)
+		<-d
+		Ω(positions.Positions[len(positions.Positions)-1]).Should(Equal([]float64{-50}))
+		// The spring carries its speed through the retarget, only the pull
+		// of the spring changes, by at most 150 * 100 / 60² per frame
+		turned := false
+		for i := 2; i < len(positions.Positions); i++ {
+			gap := positions.Frames[i].Index - positions.Frames[i-2].Index
+			if gap != 2 {
+				continue
+			}
+			v1 := positions.Positions[i-1][0] - positions.Positions[i-2][0]
+			v2 := positions.Positions[i][0] - positions.Positions[i-1][0]
+			Ω(math.Abs(v2 - v1)).Should(BeNumerically("<", 150*100/3600.+1))
+			turned = turned || v2 < 0
+		}
+		Ω(turned).Should(BeTrue())
+		Ω(engine.To).Should(Equal([]float64{-50}))
+		// Transitioned stays on the scale of the original From and To
+		for i, position := range positions.Positions {
+			Ω(position[0]).Should(BeNumerically("~", 100*positions.Frames[i].Transitioned, 1e-9))
+		}
+		Ω(positions.Frames[len(positions.Frames)-1].Transitioned).Should(Equal(-.5))
+		close(done)
+	}, 3)
+	It("should start with a velocity", func() {
+		still := NewSpringEngine([]float64{0}, []float64{0}, nil)
+		Ω(still.Settle()).Should(Equal(time.Duration(0)))
+		moving := NewSpringEngine([]float64{0}, []float64{0}, nil)
+		moving.Velocity = []float64{5}
+		Ω(moving.Settle()).Should(BeNumerically(">", 0))
+	})
+	It("should finish a spring at rest", func(done Done) {
+		d := make(chan int)
+		recorder := &Recorder{Done: d}
+		run(NewSpringEngine([]float64{3}, []float64{3}, recorder), d)
+		Ω(recorder.Frames).Should(HaveLen(2))
+		Ω(recorder.Frames[1].Index).Should(Equal(1))
+		close(done)
+	}, 2)
+	It("should jump to the target when stopped", func(done Done) {
+		d := make(chan int)
+		positions := &Positions{Recorder: Recorder{Done: d}}
+		engine := NewSpringEngine([]float64{0, 0}, []float64{1, 2}, positions)
+		engine.Start()
+		time.Sleep(50 * time.Millisecond)
+		engine.Stop()
+		engine.Stop()
+		<-d
+		Ω(positions.Positions[len(positions.Positions)-1]).Should(Equal([]float64{1, 2}))
+		close(done)
+	}, 2)
+	It("should reject mismatched dimensions", func() {
+		engine := NewSpringEngine([]float64{0}, []float64{1, 2}, nil)
+		Ω(engine.Start).Should(Panic())
+		engine.From = []float64{0, 0}
+		Ω(func() { engine.Retarget(1) }).Should(Panic())
+		engine.Velocity = []float64{1}
+		Ω(errors.Is(engine.Validate(), ErrSpringEngine)).Should(BeTrue())
+		Ω(engine.Start).Should(Panic())
+	})
+	It("should reject invalid spring parameters", func() {
+		engine := NewSpringEngine([]float64{0}, []float64{1}, nil)
+		Ω(engine.Validate()).Should(Succeed())
+		engine.Mass = 0
+		Ω(errors.Is(engine.Validate(), ErrSpringEngine)).Should(BeTrue())
+		Ω(engine.Start).Should(PanicWith(engine.Validate()))
+		engine.Mass, engine.Stiffness = 1, -100
+		Ω(errors.Is(engine.Validate(), ErrSpringEngine)).Should(BeTrue())
+		engine.Stiffness, engine.Damping = 100, -1
+		Ω(errors.Is(engine.Validate(), ErrSpringEngine)).Should(BeTrue())
+		// An undamped spring never settles
+		engine.Damping = 0
+		Ω(errors.Is(engine.Validate(), ErrSpringEngine)).Should(BeTrue())
+		engine.Damping = math.NaN()
+		Ω(errors.Is(engine.Validate(), ErrSpringEngine)).Should(BeTrue())
+		Ω(func() { engine.Settle() }).Should(Panic())
+	})
+})
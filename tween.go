@@ -1,6 +1,11 @@
 package tween
 
-import "time"
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
 
 // TransitionFunc calculates the percentage of the transition between the start
 // and end values based tween (elapsed time) completion status.
@@ -18,7 +23,8 @@ type Updater interface {
 	// begins. Start may be used to setup or pre-calculate updates.
 	//
 	// framerate is the number of frames per second in the tween
-	// frames is the total number of frames that be generated
+	// frames is the Index of the final frame, so a tween sends frames+1
+	// frames including the initial one unless some are skipped
 	// frameTime is the duration for each frame
 	// runningTime is the total duration for the entire tween
 	Start(framerate, frames int, frameTime, runningTime time.Duration)
@@ -36,6 +42,152 @@ type Frame struct {
 	Transitioned float64       // Transitioned is the percentage 0.0 - 1.0 of transition between start and end values of the tween.
 	Index        int           // Index is the current frame index
 	Elapsed      time.Duration // Elapsed is the current elapsed time in the tween.
+	Skipped      int           // Skipped is the number of frames dropped just before this frame because the tick was late.
+	Jitter       time.Duration // Jitter is how far the tick for this frame was from the ideal frame time.
+}
+
+// Progress selects how the Engine calculates Frame.Completed.
+type Progress int
+
+const (
+	// Quantized progress snaps Completed to the start of the current frame so
+	// every frame is an exact multiple of the frame time (the default).
+	Quantized Progress = iota
+	// Continuous progress calculates Completed from the actual elapsed time,
+	// including time part way through a frame.
+	Continuous
+)
+
+// CatchUp selects what the Engine does when a tick arrives late, e.g. when
+// the system is under load, and frames have been missed.
+type CatchUp int
+
+const (
+	// SkipMissed jumps straight to the current frame and reports the missed
+	// frames in Frame.Skipped (the default).
+	SkipMissed CatchUp = iota
+	// EmitMissed sends every missed frame before the current frame so an
+	// Updater sees every frame index.
+	EmitMissed
+	// CapMissed sends up to Engine.MaxCatchUp of the most recent missed frames
+	// and skips the rest.
+	CapMissed
+)
+
+// Adaptive lowers the Engine framerate while the transition changes slowly
+// and raises it again during fast motion, saving work for tweens that don't
+// need every frame. Frames are still placed on the frame grid of the Engine
+// rate, the adaptive rate only changes how many grid frames each tick
+// advances.
+type Adaptive struct {
+	MinRate   float64 // MinRate is the lowest rate in frames per second.
+	MaxRate   float64 // MaxRate is the highest rate in frames per second (defaults to the Engine rate).
+	Threshold float64 // Threshold is the smallest change in Transitioned between frames worth showing.
+}
+
+// strides calculates the fewest and most grid frames a tick may advance at a
+// given rate.
+func (a *Adaptive) strides(rate float64) (min, max int) {
+	min, max = 1, 1
+	if a.MaxRate > 0 && a.MaxRate < rate {
+		min = int(math.Ceil(rate/a.MaxRate - 1e-9))
+	}
+	if a.MinRate > 0 && a.MinRate < rate {
+		max = int(math.Floor(rate/a.MinRate + 1e-9))
+	}
+	if max < min {
+		max = min
+	}
+	return min, max
+}
+
+// adapt picks the stride for the next tick from the change in Transitioned
+// over the last stride. The stride doubles while doubling it would keep the
+// change below the threshold and halves while the change is above it.
+func (a *Adaptive) adapt(stride, min, max int, delta float64) int {
+	switch {
+	case delta > a.Threshold && stride > min:
+		stride /= 2
+	case 2*delta < a.Threshold && stride < max:
+		stride *= 2
+	}
+	if stride < min {
+		return min
+	}
+	if stride > max {
+		return max
+	}
+	return stride
+}
+
+// Marker is a point in a tween that fires side effects once when the tween
+// crosses it, e.g. to play a sound at 40% or enable a button at the end of a
+// fade in.
+type Marker struct {
+	Name      string        // Name identifies the marker.
+	At        time.Duration // At is the elapsed time of the marker, used when it isn't zero.
+	Completed float64       // Completed is the percentage 0.0 - 1.0 of elapsed time of the marker, used when At is zero.
+	Func      func(Frame)   // Func is called with the first frame at or past the marker, if set.
+}
+
+// Crossing is sent on Engine.Crossings when a Marker is crossed.
+type Crossing struct {
+	Marker Marker // Marker is the marker that was crossed.
+	Frame  Frame  // Frame is the first frame at or past the marker.
+}
+
+// markers tracks which markers a running tween has crossed. Positions are
+// measured in the direction of play, so they count down in reverse.
+type markers struct {
+	list  []Marker  // list of markers sorted by position
+	at    []float64 // at is the position of each marker
+	fired []bool    // fired is true for markers that have been crossed
+}
+
+// newMarkers sorts the markers by their position in the tween.
+func newMarkers(list []Marker, duration time.Duration, reverse bool) *markers {
+	m := &markers{
+		list:  append([]Marker(nil), list...),
+		at:    make([]float64, len(list)),
+		fired: make([]bool, len(list)),
+	}
+	position := func(marker Marker) float64 {
+		at := marker.Completed
+		if marker.At != 0 {
+			at = float64(marker.At) / float64(duration)
+		}
+		if reverse {
+			return 1 - at
+		}
+		return at
+	}
+	sort.SliceStable(m.list, func(i, j int) bool {
+		return position(m.list[i]) < position(m.list[j])
+	})
+	for i, marker := range m.list {
+		m.at[i] = position(marker)
+	}
+	return m
+}
+
+// cross fires every marker at or before position that hasn't fired yet and
+// re-arms markers after position, which the tween can only be before if it
+// was seeked backwards.
+func (m *markers) cross(position float64, frame Frame, crossings chan<- Crossing) {
+	for i, marker := range m.list {
+		switch {
+		case m.at[i] <= position && !m.fired[i]:
+			m.fired[i] = true
+			if marker.Func != nil {
+				marker.Func(frame)
+			}
+			if crossings != nil {
+				crossings <- Crossing{Marker: marker, Frame: frame}
+			}
+		case m.at[i] > position:
+			m.fired[i] = false
+		}
+	}
 }
 
 // NewEngine creates a basic tween Engine with a framerate of 60fps.
@@ -49,88 +201,342 @@ func NewEngine(duration time.Duration, transition TransitionFunc, updater Update
 }
 
 // Engine runs a tween relying on transitioner and updater.
+//
+// Every play of a tween sends exactly one initial frame with Completed 0 and
+// exactly one final frame with Completed 1 (the other way around in
+// Reverse), and the Index of each frame is larger than the one before unless
+// the tween is seeked backwards. Frames may be skipped depending on CatchUp
+// and Adaptive. Repeating tweens start again from Index 0.
 type Engine struct {
-	Duration   time.Duration  // The total duration of the tween.
-	Framerate  int            // The number of tween data points per second (defaults to 60 fps - like the real gamers use).
-	Transition TransitionFunc // Transition calculates the transition curve for the tween.
-	Updater    Updater        // Updater updates the tween values for each frame.
+	Duration   time.Duration   // The total duration of the tween.
+	Framerate  int             // The number of tween data points per second (defaults to 60 fps - like the real gamers use).
+	Rate       float64         // Rate is the number of frames per second for non-integer rates (e.g. 59.94), overriding Framerate when set.
+	Adaptive   *Adaptive       // Adaptive varies the framerate with the speed of the transition when set.
+	Transition TransitionFunc  // Transition calculates the transition curve for the tween.
+	Updater    Updater         // Updater updates the tween values for each frame.
+	Progress   Progress        // Progress selects quantized (default) or continuous frame progress.
+	CatchUp    CatchUp         // CatchUp is the policy for frames missed by late ticks.
+	MaxCatchUp int             // MaxCatchUp is the most missed frames sent per tick with CapMissed.
+	Reverse    bool            // Reverse plays the tween backwards, from Completed 1 to 0.
+	Markers    []Marker        // Markers fire once when the tween crosses them.
+	Crossings  chan<- Crossing // Crossings receives crossed markers when set - sends block the tween.
+	Repeat     int             // Repeat is the number of times to play the tween again, or negative to repeat until stopped.
+
+	mu        sync.Mutex    // mu guards the fields below
+	running   bool          // True if the tween is running
+	done      chan int      // Internal channel used to terminate the tween early
+	seeking   bool          // True if seek should be applied on the next frame
+	seek      time.Duration // seek is the elapsed play time to move to
+	paused    bool          // True if the tween is paused
+	wake      chan int      // Internal channel used to signal pause and resume
+	listeners []func(Event) // listeners receive lifecycle events
+}
 
-	running bool     // True if the tween is running
-	done    chan int // Internal channel used to terminate the tween early
+// rate is the number of frames per second.
+func (e *Engine) rate() float64 {
+	if e.Rate > 0 {
+		return e.Rate
+	}
+	return float64(e.Framerate)
+}
+
+// FrameTime calculates the duration of a single frame.
+func (e *Engine) FrameTime() time.Duration {
+	return time.Duration(float64(time.Second) / e.rate())
+}
+
+// Frames calculates the number of frame intervals in the tween. A duration
+// that is not a multiple of the frame time is rounded up so the final frame
+// is never early.
+func (e *Engine) Frames() int {
+	frames := math.Ceil(e.Duration.Seconds()*e.rate() - 1e-9)
+	if frames < 1 {
+		return 1
+	}
+	return int(frames)
 }
 
 // Start begins the tween running.
 func (e *Engine) Start() {
+	e.mu.Lock()
+	e.running = true
+	e.seeking = false
+	e.paused = false
 	e.done = make(chan int)
+	e.wake = make(chan int, 1)
+	done, wake := e.done, e.wake
+	e.mu.Unlock()
+
 	// can't stop this thread unless you call Stop() or let the timer
 	// run out
 	go func() {
-		// Setup internal done channel
-		e.done = make(chan int)
-
 		// Based on fps we can calculate how long a frame is:
-		frameDuration := time.Second / time.Duration(e.Framerate) // The duration in a frame
-		cutoff := e.Duration - frameDuration                      // The cutoff point where elapsed time is considered "done"
-		frames := int(e.Duration / frameDuration)                 // The number of frames in the duration
+		frameDuration := e.FrameTime() // The duration in a frame
+		frames := e.Frames()           // The number of frames in the duration
 
-		// start ticker
-		e.running = true
-		e.Updater.Start(e.Framerate, frames, frameDuration, e.Duration)
+		// The number of grid frames each tick advances, which only changes
+		// for adaptive framerates
+		stride, minStride, maxStride := 1, 1, 1
+		if e.Adaptive != nil {
+			minStride, maxStride = e.Adaptive.strides(e.rate())
+		}
 
-		// Send initial frame
+		var marks *markers
 		frame := Frame{}
-		e.Updater.Update(frame)
-
-		// set start time
-		ticker := time.NewTicker(frameDuration)
-		timeChan := ticker.C
-		started := time.Now()
-
-		for e.running {
-			select {
-			case <-timeChan:
-				frame.Elapsed = time.Since(started)
-
-				// Calculate the frame index - some frames can be skipped so
-				// must find correct time slot for this elapsed time
-				frame.Index = int(frame.Elapsed / frameDuration)
-
-				// Calculate the completed percentage of time
-				frame.Completed = ((float64(frame.Index) * float64(frameDuration)) / float64(e.Duration))
-				if frame.Completed > 1 {
-					go e.Stop()
-					break
-				}
+		cycle := 0
+
+		position := 0. // position is the played percentage of the last frame sent
+
+		// update updates the frame at played, the percentage 0.0 - 1.0 of
+		// elapsed play time
+		update := func(played float64) {
+			frame.Completed = played
+			if e.Reverse {
+				frame.Completed = 1 - played
+			}
+
+			// Calulate the completed percentage of the transition
+			frame.Transitioned = e.Transition(frame.Completed)
+
+			// Update the value
+			e.Updater.Update(frame)
+			e.emit(EventUpdated, frame, cycle)
+		}
+
+		// send updates the frame at played and crosses any markers it reaches
+		send := func(played float64) {
+			update(played)
+			marks.cross(played, frame, e.Crossings)
+			position = played
+		}
+
+		// start ticker
+		e.Updater.Start(int(math.Round(e.rate())), frames, frameDuration, e.Duration)
+		e.emit(EventStarted, frame, cycle)
+
+		timer := time.NewTimer(time.Hour)
+		timer.Stop()
+		defer timer.Stop()
+		var started, pausedAt time.Time
+		paused, interrupted := false, false
+
+		for ; ; cycle++ {
+			if cycle > 0 {
+				e.emit(EventRepeated, frame, cycle)
+			}
+			marks = newMarkers(e.Markers, e.Duration, e.Reverse)
+			stride = minStride
+
+			// Send initial frame
+			frame = Frame{}
+			send(0)
+
+			// set start time - ticks are scheduled from the start time so a
+			// frame time rounded to the nanosecond doesn't drift
+			started = time.Now()
+			if !paused {
+				timer.Reset(frameDuration * time.Duration(stride))
+			}
+
+		loop:
+			for {
+				select {
+				case <-timer.C:
+					elapsed := time.Since(started)
+
+					// Apply a seek by moving the start time
+					e.mu.Lock()
+					seeked := e.seeking
+					if seeked {
+						elapsed = e.seek
+						started = time.Now().Add(-elapsed)
+						e.seeking = false
+					}
+					e.mu.Unlock()
 
-				// Calulate the completed percentage of the transition
-				frame.Transitioned = e.Transition(frame.Completed)
+					// Find the frame slot the elapsed time is in - ticks can be
+					// late so frames may have been missed, but a frame is only
+					// missed once its whole slot has passed and the index must
+					// always move forward. Frames stay on the stride grid from
+					// the frame this tick was scheduled for, except the final
+					// frame.
+					next := frame.Index + stride // The frame this tick was scheduled for
+					due := int(elapsed / frameDuration)
+					if seeked {
+						next = due
+					} else if due < next {
+						due = next
+					}
+					final := due >= frames
+					if final {
+						due = frames
+					} else {
+						due -= (due - next) % stride
+					}
 
-				// Update the value
-				e.Updater.Update(frame)
+					// missed is the number of grid frames before the due frame
+					missed := 0
+					if due > next {
+						missed = (due - next + stride - 1) / stride
+					}
+					catchUp := missed
+					switch e.CatchUp {
+					case SkipMissed:
+						catchUp = 0
+					case CapMissed:
+						if catchUp > e.MaxCatchUp {
+							catchUp = e.MaxCatchUp
+						}
+						if catchUp < 0 {
+							catchUp = 0
+						}
+					}
+					skipped := missed - catchUp
+					first := next + skipped*stride
 
-				// see if we should keep going
-				if frame.Elapsed > cutoff {
-					go e.Stop() // terminate ourself
+					jitter := elapsed - time.Duration(due)*frameDuration
+					previous := frame.Transitioned
+					for index := first; index < frames && index <= due; index += stride {
+						previous = frame.Transitioned
+						frame.Index = index
+						frame.Skipped = skipped
+						// Only the frame for this tick is late, any caught up
+						// frames are sent as if they were on time
+						frame.Elapsed = time.Duration(index) * frameDuration
+						frame.Jitter = 0
+						if index == due {
+							frame.Elapsed = elapsed
+							frame.Jitter = jitter
+						}
+
+						// Calculate the completed percentage of time
+						played := (float64(index) * float64(frameDuration)) / float64(e.Duration)
+						if e.Progress == Continuous {
+							played = float64(frame.Elapsed) / float64(e.Duration)
+						}
+						send(math.Min(played, 1))
+						skipped = 0
+					}
+
+					// the final frame is sent once the tween is over
+					if final {
+						frame.Skipped = skipped
+						frame.Jitter = jitter
+						break loop
+					}
+
+					if e.Adaptive != nil {
+						stride = e.Adaptive.adapt(stride, minStride, maxStride, math.Abs(frame.Transitioned-previous))
+					}
+					timer.Reset(time.Until(started.Add(time.Duration(frame.Index+stride) * frameDuration)))
+				case <-wake:
+					e.mu.Lock()
+					pause := e.paused
+					e.mu.Unlock()
+					if pause && !paused {
+						// Stop the clock until the tween is resumed
+						paused = true
+						pausedAt = time.Now()
+						if !timer.Stop() {
+							select {
+							case <-timer.C:
+							default:
+							}
+						}
+						e.emit(EventPaused, frame, cycle)
+					} else if !pause && paused {
+						paused = false
+						started = started.Add(time.Since(pausedAt))
+						timer.Reset(time.Until(started.Add(time.Duration(frame.Index+stride) * frameDuration)))
+						e.emit(EventResumed, frame, cycle)
+					}
+				case <-done:
+					frame.Skipped = 0
+					frame.Jitter = 0
+					interrupted = true
+					break loop
 				}
-			case <-e.done:
-				ticker.Stop()
+			}
+
+			last := interrupted || (e.Repeat >= 0 && cycle >= e.Repeat)
+			if last {
+				e.mu.Lock()
 				e.running = false
+				e.mu.Unlock()
+			}
+
+			// Send the final frame - a stopped tween jumps to the end values
+			// but only crosses the markers it reached
+			frame.Elapsed = e.Duration
+			frame.Index = frames
+			update(1)
+			if !interrupted {
+				position = 1
+			}
+			marks.cross(position, frame, e.Crossings)
+			if last {
+				break
 			}
 		}
 
-		// cleanup
-		frame.Elapsed = e.Duration
-		frame.Completed = 1
-		frame.Transitioned = 1
-		frame.Index = frames
-		e.Updater.Update(frame)
+		if interrupted {
+			e.emit(EventInterrupted, frame, cycle)
+		} else {
+			e.emit(EventCompleted, frame, cycle)
+		}
 		e.Updater.End()
 	}()
 }
 
-// Stop terminates the tween immediately.
+// Seek moves a running tween to completed, the percentage 0.0 - 1.0 of
+// elapsed time, from the next frame. Markers jumped over in the direction of
+// play fire, markers jumped back over fire again when they are next
+// crossed. Seek does nothing if the tween is not running.
+func (e *Engine) Seek(completed float64) {
+	completed = math.Max(0, math.Min(1, completed))
+	if e.Reverse {
+		completed = 1 - completed
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.running {
+		e.seeking = true
+		e.seek = time.Duration(completed * float64(e.Duration))
+	}
+}
+
+// Pause stops the clock of a running tween until Resume is called. Pause
+// does nothing if the tween is not running.
+func (e *Engine) Pause() {
+	e.signal(true)
+}
+
+// Resume continues a paused tween from where it was paused.
+func (e *Engine) Resume() {
+	e.signal(false)
+}
+
+// signal changes the paused state and wakes the tween to apply it.
+func (e *Engine) signal(paused bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.running && e.paused != paused {
+		e.paused = paused
+		select {
+		case e.wake <- 1:
+		default:
+		}
+	}
+}
+
+// Stop terminates the tween immediately, jumping to the final frame without
+// firing the markers the tween hasn't reached. Stop does nothing if the tween
+// is not running.
 func (e *Engine) Stop() {
-	if e.running == true {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.running {
+		e.running = false
 		close(e.done)
 	}
 }
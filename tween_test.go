@@ -34,15 +34,39 @@ func (u *Recorder) End() {
 	u.Done <- 1
 }
 
+// Staller is a Recorder that blocks once at a frame index, causing the
+// following ticks to arrive late.
+type Staller struct {
+	Recorder
+	At  int
+	For time.Duration
+}
+
+func (u *Staller) Update(frame Frame) {
+	u.Recorder.Update(frame)
+	if frame.Index == u.At {
+		time.Sleep(u.For)
+	}
+}
+
+// run runs the engine and waits for it to finish.
+func run(engine interface{ Start() }, done chan int) {
+	engine.Start()
+	<-done
+}
+
 var _ = Describe("Core", func() {
 	Describe("Engine", func() {
 		It("should generate frames", func(done Done) {
 			d := make(chan int)
 			recorder := &Recorder{Done: d}
 			engine := NewEngine(time.Second, curves.Linear, recorder)
+			// Late ticks send the missed frames so none are dropped
+			engine.CatchUp = EmitMissed
 			engine.Start()
 			<-d
 			Ω(recorder.FPS).Should(Equal(60))
+			// 60 frame intervals is 61 frames including the initial frame
 			Ω(recorder.TotalFrames).Should(Equal(60))
 			Ω(recorder.FTime).Should(Equal(16666666 * time.Nanosecond))
 			Ω(recorder.Running).Should(Equal(time.Second))
@@ -51,8 +75,297 @@ var _ = Describe("Core", func() {
 			Ω(last.Completed).Should(Equal(1.))
 			Ω(last.Transitioned).Should(Equal(1.))
 			Ω(last.Elapsed).Should(Equal(time.Second))
-			//Ω(recorder.Frames).Should(HaveLen(61))
-			//Ω(recorder.Frames).Should(Equal([]Frame{}))
+			Ω(recorder.Frames).Should(HaveLen(61))
+			close(done)
+		}, 2)
+		It("should send exactly one initial and one final frame", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			engine := NewEngine(1010*time.Millisecond, curves.Linear, recorder)
+			engine.CatchUp = EmitMissed
+			run(engine, d)
+			// 1010ms is 60.6 frames, rounded up so the final frame isn't early
+			Ω(engine.Frames()).Should(Equal(61))
+			Ω(recorder.TotalFrames).Should(Equal(61))
+			Ω(recorder.Frames).Should(HaveLen(62))
+			Ω(recorder.Frames[0]).Should(Equal(Frame{}))
+			last := recorder.Frames[len(recorder.Frames)-1]
+			Ω(last.Index).Should(Equal(61))
+			Ω(last.Elapsed).Should(Equal(1010 * time.Millisecond))
+			starts, ends := 0, 0
+			for i, frame := range recorder.Frames {
+				if i > 0 {
+					Ω(frame.Index).Should(Equal(recorder.Frames[i-1].Index + 1))
+				}
+				if frame.Completed == 0 {
+					starts++
+				}
+				if frame.Completed == 1 {
+					ends++
+				}
+			}
+			Ω(starts).Should(Equal(1))
+			Ω(ends).Should(Equal(1))
+			close(done)
+		}, 2)
+		It("should jump to the final frame when stopped", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			engine := NewEngine(time.Second, curves.Linear, recorder)
+			engine.Start()
+			time.Sleep(100 * time.Millisecond)
+			engine.Stop()
+			engine.Stop()
+			<-d
+			last := recorder.Frames[len(recorder.Frames)-1]
+			Ω(last.Index).Should(Equal(60))
+			Ω(last.Completed).Should(Equal(1.))
+			Ω(recorder.Frames[len(recorder.Frames)-2].Completed).Should(BeNumerically("<", .5))
+			close(done)
+		}, 2)
+		It("should calculate continuous progress", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			engine := NewEngine(300*time.Millisecond, curves.Linear, recorder)
+			engine.Progress = Continuous
+			run(engine, d)
+			for _, frame := range recorder.Frames {
+				Ω(frame.Completed).Should(Equal(float64(frame.Elapsed) / float64(300*time.Millisecond)))
+			}
+			close(done)
+		}, 2)
+		It("should report tick jitter", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			run(NewEngine(300*time.Millisecond, curves.Linear, recorder), d)
+			frameTime := time.Second / 60
+			for _, frame := range recorder.Frames[1 : len(recorder.Frames)-1] {
+				Ω(frame.Jitter).Should(Equal(frame.Elapsed - time.Duration(frame.Index)*frameTime))
+				Ω(frame.Completed).Should(Equal(float64(time.Duration(frame.Index)*frameTime) / float64(300*time.Millisecond)))
+			}
+			close(done)
+		}, 2)
+		It("should skip missed frames", func(done Done) {
+			d := make(chan int)
+			staller := &Staller{Recorder: Recorder{Done: d}, At: 3, For: 100 * time.Millisecond}
+			run(NewEngine(500*time.Millisecond, curves.Linear, staller), d)
+			skipped := 0
+			for i, frame := range staller.Frames[1:] {
+				Ω(frame.Index - staller.Frames[i].Index).Should(BeNumerically(">=", 1))
+				if frame.Skipped > 0 {
+					Ω(frame.Index - staller.Frames[i].Index).Should(Equal(frame.Skipped + 1))
+					skipped += frame.Skipped
+				}
+			}
+			Ω(skipped).Should(BeNumerically(">=", 4))
+			close(done)
+		}, 2)
+		It("should emit missed frames", func(done Done) {
+			d := make(chan int)
+			staller := &Staller{Recorder: Recorder{Done: d}, At: 3, For: 100 * time.Millisecond}
+			engine := NewEngine(500*time.Millisecond, curves.Linear, staller)
+			engine.CatchUp = EmitMissed
+			run(engine, d)
+			for i, frame := range staller.Frames[1:] {
+				Ω(frame.Skipped).Should(Equal(0))
+				Ω(frame.Index).Should(Equal(staller.Frames[i].Index + 1))
+			}
+			close(done)
+		}, 2)
+		It("should cap the missed frames", func(done Done) {
+			d := make(chan int)
+			staller := &Staller{Recorder: Recorder{Done: d}, At: 3, For: 100 * time.Millisecond}
+			engine := NewEngine(500*time.Millisecond, curves.Linear, staller)
+			engine.CatchUp = CapMissed
+			engine.MaxCatchUp = 2
+			run(engine, d)
+			// The late tick sends the two most recent missed frames and the current frame
+			Ω(staller.Frames[4].Index).Should(Equal(4 + staller.Frames[4].Skipped))
+			Ω(staller.Frames[4].Skipped).Should(BeNumerically(">=", 2))
+			Ω(staller.Frames[5].Index).Should(Equal(staller.Frames[4].Index + 1))
+			Ω(staller.Frames[6].Index).Should(Equal(staller.Frames[4].Index + 2))
+			Ω(staller.Frames[5].Skipped).Should(Equal(0))
+			close(done)
+		}, 2)
+		It("should support non-integer framerates", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			engine := NewEngine(500*time.Millisecond, curves.Linear, recorder)
+			engine.Rate = 59.94
+			run(engine, d)
+			Ω(engine.FrameTime()).Should(Equal(16683350 * time.Nanosecond))
+			Ω(recorder.FPS).Should(Equal(60))
+			Ω(recorder.TotalFrames).Should(Equal(30))
+			Ω(recorder.FTime).Should(Equal(engine.FrameTime()))
+			Ω(recorder.Frames[len(recorder.Frames)-1].Index).Should(Equal(30))
+			close(done)
+		}, 2)
+		It("should lower the framerate for slow motion", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			engine := NewEngine(time.Second, curves.Linear, recorder)
+			engine.Adaptive = &Adaptive{MinRate: 10, Threshold: .05}
+			run(engine, d)
+			// Two frames at a time keeps each step of 1/30 below the threshold
+			Ω(len(recorder.Frames)).Should(BeNumerically("<", 40))
+			for i, frame := range recorder.Frames[2 : len(recorder.Frames)-1] {
+				Ω(frame.Index - recorder.Frames[i+1].Index).Should(BeNumerically(">=", 2))
+				Ω(frame.Transitioned - recorder.Frames[i+1].Transitioned).Should(BeNumerically("<", .05))
+			}
+			close(done)
+		}, 2)
+		It("should raise the framerate for fast motion", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			engine := NewEngine(time.Second, curves.EaseInQuint, recorder)
+			engine.Adaptive = &Adaptive{MinRate: 5, Threshold: .02}
+			engine.CatchUp = EmitMissed
+			run(engine, d)
+			first := recorder.Frames[2].Index - recorder.Frames[1].Index
+			n := len(recorder.Frames)
+			last := recorder.Frames[n-2].Index - recorder.Frames[n-3].Index
+			Ω(first).Should(BeNumerically(">", last))
+			Ω(last).Should(Equal(1))
+			for i, frame := range recorder.Frames[1:] {
+				Ω(frame.Index - recorder.Frames[i].Index).Should(BeNumerically("<=", 12))
+			}
+			close(done)
+		}, 2)
+		It("should limit the adaptive framerate", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			engine := NewEngine(500*time.Millisecond, curves.Linear, recorder)
+			engine.Adaptive = &Adaptive{MaxRate: 20}
+			engine.CatchUp = EmitMissed
+			run(engine, d)
+			for _, frame := range recorder.Frames {
+				Ω(frame.Index % 3).Should(Equal(0))
+			}
+			Ω(recorder.Frames).Should(HaveLen(11))
+			close(done)
+		}, 2)
+		It("should keep late adaptive frames on the stride grid", func(done Done) {
+			for _, catchUp := range []CatchUp{SkipMissed, EmitMissed} {
+				d := make(chan int)
+				staller := &Staller{Recorder: Recorder{Done: d}, At: 3, For: 130 * time.Millisecond}
+				engine := NewEngine(500*time.Millisecond, curves.Linear, staller)
+				engine.Adaptive = &Adaptive{MaxRate: 20}
+				engine.CatchUp = catchUp
+				run(engine, d)
+				frames := staller.Frames
+				sent := 1
+				for i, frame := range frames[1:] {
+					Ω(frame.Index % 3).Should(Equal(0))
+					// Every grid frame is either sent or reported as skipped
+					Ω(frame.Index - frames[i].Index).Should(Equal(3 * (frame.Skipped + 1)))
+					sent += 1 + frame.Skipped
+				}
+				Ω(sent).Should(Equal(11))
+				if catchUp == EmitMissed {
+					Ω(frames).Should(HaveLen(11))
+				}
+			}
+			close(done)
+		}, 3)
+		It("should fire markers once when crossed", func(done Done) {
+			d := make(chan int)
+			staller := &Staller{Recorder: Recorder{Done: d}, At: 3, For: 150 * time.Millisecond}
+			crossings := make(chan Crossing, 10)
+			fired := []Frame{}
+			engine := NewEngine(500*time.Millisecond, curves.Linear, staller)
+			engine.Markers = []Marker{
+				{Name: "end", Completed: 1},
+				{Name: "skipped", At: 100 * time.Millisecond, Func: func(frame Frame) { fired = append(fired, frame) }},
+				{Name: "start"},
+				{Name: "half", Completed: .5},
+			}
+			engine.Crossings = crossings
+			run(engine, d)
+			close(crossings)
+			names := []string{}
+			for crossing := range crossings {
+				names = append(names, crossing.Marker.Name)
+				Ω(crossing.Frame.Completed).Should(BeNumerically(">=", crossing.Marker.Completed))
+			}
+			Ω(names).Should(Equal([]string{"start", "skipped", "half", "end"}))
+			// The stall skips past the marker, which fires on the next frame
+			Ω(fired).Should(HaveLen(1))
+			Ω(fired[0].Skipped).Should(BeNumerically(">", 0))
+			Ω(fired[0].Elapsed).Should(BeNumerically(">=", 100*time.Millisecond))
+			close(done)
+		}, 2)
+		It("should not fire unreached markers when stopped", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			crossings := make(chan Crossing, 10)
+			engine := NewEngine(time.Second, curves.Linear, recorder)
+			engine.Markers = []Marker{{Name: "start"}, {Name: "half", Completed: .5}, {Name: "end", Completed: 1}}
+			engine.Crossings = crossings
+			engine.Start()
+			time.Sleep(100 * time.Millisecond)
+			engine.Stop()
+			<-d
+			close(crossings)
+			names := []string{}
+			for crossing := range crossings {
+				names = append(names, crossing.Marker.Name)
+			}
+			Ω(names).Should(Equal([]string{"start"}))
+			last := recorder.Frames[len(recorder.Frames)-1]
+			Ω(last.Completed).Should(Equal(1.))
+			close(done)
+		}, 2)
+		It("should end where the curve ends", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			engine := NewEngine(100*time.Millisecond, curves.Mirror(curves.Linear), recorder)
+			run(engine, d)
+			last := recorder.Frames[len(recorder.Frames)-1]
+			Ω(last.Completed).Should(Equal(1.))
+			Ω(last.Transitioned).Should(Equal(0.))
+			close(done)
+		}, 2)
+		It("should play in reverse", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			crossings := make(chan Crossing, 10)
+			engine := NewEngine(200*time.Millisecond, curves.EaseInQuad, recorder)
+			engine.Reverse = true
+			engine.Markers = []Marker{{Name: "early", Completed: .25}, {Name: "late", Completed: .75}}
+			engine.Crossings = crossings
+			run(engine, d)
+			first, last := recorder.Frames[0], recorder.Frames[len(recorder.Frames)-1]
+			Ω(first.Completed).Should(Equal(1.))
+			Ω(first.Transitioned).Should(Equal(1.))
+			Ω(last.Completed).Should(Equal(0.))
+			Ω(last.Transitioned).Should(Equal(0.))
+			Ω(last.Index).Should(Equal(12))
+			Ω((<-crossings).Marker.Name).Should(Equal("late"))
+			crossing := <-crossings
+			Ω(crossing.Marker.Name).Should(Equal("early"))
+			Ω(crossing.Frame.Completed).Should(BeNumerically("<=", .25))
+			close(done)
+		}, 2)
+		It("should fire markers when seeking", func(done Done) {
+			d := make(chan int)
+			recorder := &Recorder{Done: d}
+			crossings := make(chan Crossing, 10)
+			engine := NewEngine(time.Second, curves.Linear, recorder)
+			engine.Markers = []Marker{{Name: "middle", Completed: .5}}
+			engine.Crossings = crossings
+			engine.Seek(.9) // not running
+			engine.Start()
+			time.Sleep(50 * time.Millisecond)
+			engine.Seek(.8)
+			Ω((<-crossings).Frame.Completed).Should(BeNumerically("~", .8, .02))
+			engine.Seek(.1)
+			time.Sleep(50 * time.Millisecond)
+			engine.Seek(.6)
+			Ω((<-crossings).Frame.Completed).Should(BeNumerically("~", .6, .02))
+			engine.Stop()
+			<-d
+			Ω(crossings).ShouldNot(Receive())
+			Ω(recorder.Frames[1].Completed).Should(BeNumerically("<", .1))
 			close(done)
 		}, 2)
 	})
@@ -2,6 +2,7 @@ package updaters
 
 import (
 	"image/color"
+	"sync"
 	"time"
 
 	"github.com/gopackage/tween"
@@ -29,33 +30,92 @@ type Color struct {
 	Updates chan color.RGBA // A channel that receives color updates
 	Done    chan int        // A channel to receive a done signal
 
-	from color.RGBA // from is the starting color snapshot
-	to   color.RGBA // to is the ending color snapshot
-	r    float64    // r is the total red transition
-	g    float64    // r is the total green transition
-	b    float64    // b is the total blue transition
-	a    float64    // b is the total alpha transition
+	mu        sync.Mutex    // mu guards the snapshots and retargets
+	from      color.RGBA    // from is the starting color snapshot
+	to        color.RGBA    // to is the ending color snapshot
+	r         float64       // r is the total red transition
+	g         float64       // r is the total green transition
+	b         float64       // b is the total blue transition
+	a         float64       // b is the total alpha transition
+	running   time.Duration // running is the duration of the tween
+	elapsed   time.Duration // elapsed is the elapsed time of the last frame
+	pending   []color.RGBA  // pending are retargets waiting for the next frame
+	retargets []colorTarget // retargets are the end colors blended in during the tween
+}
+
+// colorTarget is a new end color for a running tween.
+type colorTarget struct {
+	at time.Duration // at is the elapsed time when the tween was retargeted
+	to color.RGBA    // to is the new end color
 }
 
 // Start begins the color update.
 func (c *Color) Start(framerate, frames int, frameTime, runningTime time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	// Snapshot the color values - just in case someone tries to change it
 	c.from = c.From
 	c.to = c.To
-	// Calculate how much each color changes during the tween
+	c.running = runningTime
+	c.elapsed = 0
+	c.pending = nil
+	c.retargets = nil
+	c.delta()
+}
+
+// delta calculates how much each color changes during the tween.
+func (c *Color) delta() {
 	c.r = float64(int(c.to.R) - int(c.from.R))
 	c.g = float64(int(c.to.G) - int(c.from.G))
 	c.b = float64(int(c.to.B) - int(c.from.B))
 	c.a = float64(int(c.to.A) - int(c.from.A))
 }
 
+// Retarget changes the color a running tween ends at, starting from the
+// next frame. The tween moves from its current color and velocity towards
+// the new color so it doesn't visibly jump, arriving at the end of the
+// tween. Retarget may be called while the tween is running.
+func (c *Color) Retarget(to color.Color) {
+	r, g, b, a := to.RGBA()
+	t := color.RGBA{uint8(r), uint8(g), uint8(b), uint8(a)}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.To = t
+	c.pending = append(c.pending, t)
+}
+
 // Update interpolates the color between start and end.
 func (c *Color) Update(frame tween.Frame) {
+	c.mu.Lock()
+	if frame.Index == 0 && len(c.retargets) > 0 {
+		// A repeating tween plays again to the last end color
+		c.to = c.retargets[len(c.retargets)-1].to
+		c.retargets = nil
+		c.delta()
+	}
+	for _, to := range c.pending {
+		c.retargets = append(c.retargets, colorTarget{at: c.elapsed, to: to})
+	}
+	c.pending = nil
+	c.elapsed = frame.Elapsed
+
+	r, g, b, a := c.r, c.g, c.b, c.a
+	for _, target := range c.retargets {
+		// Each retarget blends the end color towards its own
+		w := blend(target.at, frame.Elapsed, c.running)
+		r += (float64(int(target.to.R)-int(c.from.R)) - r) * w
+		g += (float64(int(target.to.G)-int(c.from.G)) - g) * w
+		b += (float64(int(target.to.B)-int(c.from.B)) - b) * w
+		a += (float64(int(target.to.A)-int(c.from.A)) - a) * w
+	}
+	from := c.from
+	c.mu.Unlock()
+
 	c.Updates <- color.RGBA{
-		R: c.from.R + uint8(c.r*frame.Transitioned),
-		G: c.from.G + uint8(c.g*frame.Transitioned),
-		B: c.from.B + uint8(c.b*frame.Transitioned),
-		A: c.from.A + uint8(c.a*frame.Transitioned),
+		R: from.R + uint8(r*frame.Transitioned),
+		G: from.G + uint8(g*frame.Transitioned),
+		B: from.B + uint8(b*frame.Transitioned),
+		A: from.A + uint8(a*frame.Transitioned),
 	}
 }
 
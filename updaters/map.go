@@ -0,0 +1,204 @@
+package updaters
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/gopackage/tween"
+)
+
+// ErrMismatch is returned when a key has values in from and to that can't be
+// tweened between, such as a number and a color or vectors of different
+// lengths.
+var ErrMismatch = errors.New("updaters: mismatched values")
+
+// NewMap creates a map updater that tweens every key present in both from
+// and to, and initializes unbuffered channels for Updates and Done signal.
+// Numbers, color.Color values and vectors (slices or arrays of numbers, such
+// as []float64 or the []interface{} of decoded JSON) can be tweened. Numbers
+// of different types are tweened as float64.
+//
+// A key that can't be tweened makes NewMap return an error wrapping
+// ErrMismatch or ErrUnsupported, unless skip is true in which case the key is
+// left out of the updates and listed in Skipped.
+//
+// Start reads From and To again, so they may be changed between tweens. Keys
+// that can't be tweened at Start are always skipped.
+func NewMap(from, to map[string]interface{}, skip bool) (*Map, error) {
+	m := &Map{
+		From:    from,
+		To:      to,
+		Updates: make(chan map[string]interface{}),
+		Done:    make(chan int),
+	}
+	var err error
+	m.properties, m.Skipped, err = mapProperties(from, to, skip)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// mapProperties finds the values of the keys present in both from and to,
+// sorted by key, and the keys that were skipped.
+func mapProperties(from, to map[string]interface{}, skip bool) ([]property, []string, error) {
+	keys := []string{}
+	for key := range to {
+		if _, ok := from[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var props []property
+	var skipped []string
+	for _, key := range keys {
+		p, err := newProperty(key, from[key], to[key])
+		if err != nil {
+			if !skip {
+				return nil, nil, err
+			}
+			skipped = append(skipped, key)
+			continue
+		}
+		props = append(props, p)
+	}
+	return props, skipped, nil
+}
+
+// Map provides tween support for maps of values, such as the properties of
+// a scripted or configured scene. Each update is a new map.
+type Map struct {
+	From    map[string]interface{}      // From the values we transition from
+	To      map[string]interface{}      // To the values we transition to
+	Updates chan map[string]interface{} // A channel that receives value updates
+	Done    chan int                    // A channel to receive a done signal
+	Skipped []string                    // Skipped lists the keys that couldn't be tweened, in order
+
+	properties []property // properties are the values being tweened
+}
+
+// property is a value in a map being tweened.
+type property struct {
+	key    string        // key of the value in the map
+	t      reflect.Type  // t is the type of the tweened value
+	from   reflect.Value // from is the starting value
+	to     reflect.Value // to is the ending value
+	vector bool          // vector is true if from and to hold []float64 vectors
+}
+
+// newProperty checks two values can be tweened between.
+func newProperty(key string, from, to interface{}) (property, error) {
+	f, t := reflect.ValueOf(from), reflect.ValueOf(to)
+	if !f.IsValid() || !t.IsValid() {
+		return property{}, fmt.Errorf("%w %q: no value", ErrUnsupported, key)
+	}
+	p := property{key: key, t: t.Type(), from: f, to: t}
+	mismatch := fmt.Errorf("%w %q: %T and %T", ErrMismatch, key, from, to)
+	switch {
+	case t.Type().Implements(colorType):
+		if !f.Type().Implements(colorType) {
+			return p, mismatch
+		}
+		if _, ok := colorModels[p.t]; !ok {
+			p.t = colorType
+		}
+	case numeric(t.Kind()):
+		if !numeric(f.Kind()) {
+			return p, mismatch
+		}
+		if f.Type() != t.Type() {
+			p.t = reflect.TypeOf(0.)
+			p.from, p.to = f.Convert(p.t), t.Convert(p.t)
+		}
+	case vector(t):
+		if !vector(f) {
+			return p, mismatch
+		}
+		if f.Len() != t.Len() {
+			return p, fmt.Errorf("%w %q: vectors of length %d and %d", ErrMismatch, key, f.Len(), t.Len())
+		}
+		// Copy the vectors so later changes don't affect the tween
+		p.vector = true
+		p.from = reflect.ValueOf(append([]float64(nil), floats(f)...))
+		p.to = reflect.ValueOf(append([]float64(nil), floats(t)...))
+	default:
+		return p, fmt.Errorf("%w %q: can't tween %T", ErrUnsupported, key, to)
+	}
+	return p, nil
+}
+
+// element unwraps an element of a vector.
+func element(v reflect.Value, i int) reflect.Value {
+	e := v.Index(i)
+	for e.Kind() == reflect.Interface && !e.IsNil() {
+		e = e.Elem()
+	}
+	return e
+}
+
+// vector reports if a value is a slice or array of numbers.
+func vector(v reflect.Value) bool {
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return false
+	}
+	for i := 0; i < v.Len(); i++ {
+		if !numeric(element(v, i).Kind()) {
+			return false
+		}
+	}
+	return true
+}
+
+// floats converts a vector to float64.
+func floats(v reflect.Value) []float64 {
+	f := make([]float64, v.Len())
+	for i := range f {
+		f[i] = element(v, i).Convert(reflect.TypeOf(0.)).Float()
+	}
+	return f
+}
+
+// at interpolates the property.
+func (p property) at(transitioned float64) interface{} {
+	if !p.vector {
+		return lerp(p.t, p.from, p.to, transitioned).Interface()
+	}
+	// Vectors are built in the type of the end value
+	from, to := p.from.Interface().([]float64), p.to.Interface().([]float64)
+	out := reflect.New(p.t).Elem()
+	if p.t.Kind() == reflect.Slice {
+		out = reflect.MakeSlice(p.t, len(to), len(to))
+	}
+	for i := range to {
+		e := out.Index(i)
+		if numeric(e.Kind()) {
+			e.Set(number(e.Type(), from[i]+(to[i]-from[i])*transitioned))
+		} else {
+			// Elements of []interface{} are float64 like decoded JSON
+			e.Set(reflect.ValueOf(from[i] + (to[i]-from[i])*transitioned))
+		}
+	}
+	return out.Interface()
+}
+
+// Start begins the map update, snapshotting the values of From and To.
+func (m *Map) Start(framerate, frames int, frameTime, runningTime time.Duration) {
+	m.properties, m.Skipped, _ = mapProperties(m.From, m.To, true)
+}
+
+// Update sends a new map of the interpolated values.
+func (m *Map) Update(frame tween.Frame) {
+	values := make(map[string]interface{}, len(m.properties))
+	for _, p := range m.properties {
+		values[p.key] = p.at(frame.Transitioned)
+	}
+	m.Updates <- values
+}
+
+// End terminates the map updates.
+func (m *Map) End() {
+	close(m.Done)
+}
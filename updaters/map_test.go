@@ -0,0 +1,122 @@
+package updaters_test
+
+import (
+	"errors"
+	"image/color"
+	"time"
+
+	"github.com/gopackage/tween"
+	"github.com/gopackage/tween/curves"
+	. "github.com/gopackage/tween/updaters"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Map Tween", func() {
+	// next sends a frame and receives the map it produces
+	next := func(updater *Map, transitioned float64) map[string]interface{} {
+		go at(updater, transitioned)
+		return <-updater.Updates
+	}
+	It("should tween keys in both maps", func() {
+		from := map[string]interface{}{
+			"x":       10,
+			"opacity": 1.,
+			"scale":   1,
+			"tint":    color.RGBA{255, 0, 0, 255},
+			"gray":    color.Black,
+			"pos":     []float64{0, 10},
+			"size":    [2]int{0, 0},
+			"path":    []interface{}{0., 1.},
+			"only":    5,
+		}
+		to := map[string]interface{}{
+			"x":       20,
+			"opacity": 0.,
+			"scale":   2.5,
+			"tint":    color.RGBA{0, 0, 255, 255},
+			"gray":    color.White,
+			"pos":     []float64{100, 10},
+			"size":    [2]int{3, 5},
+			"path":    []interface{}{4., 3},
+			"extra":   1,
+		}
+		updater, err := NewMap(from, to, false)
+		Ω(err).Should(BeNil())
+		Ω(updater.Skipped).Should(BeEmpty())
+		values := next(updater, .5)
+		Ω(values).Should(Equal(map[string]interface{}{
+			"x":       15,
+			"opacity": .5,
+			"scale":   1.75,
+			"tint":    color.RGBA{128, 0, 128, 255},
+			"gray":    color.Gray16{0x8000},
+			"pos":     []float64{50, 10},
+			"size":    [2]int{2, 3},
+			"path":    []interface{}{2., 2.},
+		}))
+		// Every update is a new map
+		from["pos"].([]float64)[0] = 1000
+		values["x"] = 0
+		Ω(next(updater, 1)).Should(Equal(map[string]interface{}{
+			"x":       20,
+			"opacity": 0.,
+			"scale":   2.5,
+			"tint":    color.RGBA{0, 0, 255, 255},
+			"gray":    color.Gray16{0xffff},
+			"pos":     []float64{100, 10},
+			"size":    [2]int{3, 5},
+			"path":    []interface{}{4., 3.},
+		}))
+	})
+	It("should report values it can't tween", func() {
+		_, err := NewMap(map[string]interface{}{"x": 1}, map[string]interface{}{"x": "left"}, false)
+		Ω(errors.Is(err, ErrUnsupported)).Should(BeTrue())
+		_, err = NewMap(map[string]interface{}{"x": "1"}, map[string]interface{}{"x": 2}, false)
+		Ω(errors.Is(err, ErrMismatch)).Should(BeTrue())
+		Ω(err).Should(MatchError(`updaters: mismatched values "x": string and int`))
+		_, err = NewMap(map[string]interface{}{"c": 1}, map[string]interface{}{"c": color.White}, false)
+		Ω(errors.Is(err, ErrMismatch)).Should(BeTrue())
+		_, err = NewMap(map[string]interface{}{"v": []int{1}}, map[string]interface{}{"v": []int{1, 2}}, false)
+		Ω(errors.Is(err, ErrMismatch)).Should(BeTrue())
+		_, err = NewMap(map[string]interface{}{"v": nil}, map[string]interface{}{"v": 1}, false)
+		Ω(errors.Is(err, ErrUnsupported)).Should(BeTrue())
+	})
+	It("should skip values it can't tween", func() {
+		updater, err := NewMap(
+			map[string]interface{}{"x": 1, "name": "a", "v": []float64{1}},
+			map[string]interface{}{"x": 3, "name": "b", "v": []float64{1, 2}},
+			true)
+		Ω(err).Should(BeNil())
+		Ω(updater.Skipped).Should(Equal([]string{"name", "v"}))
+		Ω(next(updater, .5)).Should(Equal(map[string]interface{}{"x": 2}))
+	})
+	It("should read From and To when started", func() {
+		updater, err := NewMap(map[string]interface{}{"x": 0}, map[string]interface{}{"x": 10}, false)
+		Ω(err).Should(BeNil())
+		updater.From = map[string]interface{}{"x": 100, "y": 0., "name": "a"}
+		updater.To = map[string]interface{}{"x": 200, "y": 1., "name": "b"}
+		updater.Start(60, 60, time.Second/60, time.Second)
+		Ω(updater.Skipped).Should(Equal([]string{"name"}))
+		Ω(next(updater, .5)).Should(Equal(map[string]interface{}{"x": 150, "y": .5}))
+	})
+	It("should tween a map with an engine", func(done Done) {
+		updater, err := NewMap(map[string]interface{}{"x": 0.}, map[string]interface{}{"x": 1.}, false)
+		Ω(err).Should(BeNil())
+		engine := tween.NewEngine(100*time.Millisecond, curves.Linear, updater)
+		engine.Start()
+		values := []map[string]interface{}{}
+		for running := true; running; {
+			select {
+			case v := <-updater.Updates:
+				values = append(values, v)
+			case <-updater.Done:
+				running = false
+			}
+		}
+		Ω(values[0]["x"]).Should(Equal(0.))
+		Ω(values[len(values)-1]["x"]).Should(Equal(1.))
+		close(done)
+	}, 2)
+})
@@ -0,0 +1,18 @@
+package updaters
+
+import "time"
+
+// blend is the weight of a new end value at elapsed for a tween retargeted
+// at the elapsed time at. The weight rises from 0 at the retarget to 1 at
+// the end of the tween along a smoothstep, which starts flat so the tween
+// keeps its old velocity at the moment it is retargeted.
+func blend(at, elapsed, running time.Duration) float64 {
+	if elapsed >= running || at >= running {
+		return 1
+	}
+	s := float64(elapsed-at) / float64(running-at)
+	if s <= 0 {
+		return 0
+	}
+	return s * s * (3 - 2*s)
+}
@@ -0,0 +1,311 @@
+package updaters
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gopackage/tween"
+)
+
+// ErrTarget is returned when the value to tween is not a pointer to a struct.
+var ErrTarget = errors.New("updaters: target must be a non-nil pointer to a struct")
+
+// ErrField is returned when an end value names a field the target doesn't
+// have.
+var ErrField = errors.New("updaters: unknown field")
+
+// ErrUnsupported is returned when a value can't be tweened, such as a string
+// field or a number given for a color.
+var ErrUnsupported = errors.New("updaters: unsupported value")
+
+// colorType is the color.Color interface type.
+var colorType = reflect.TypeOf((*color.Color)(nil)).Elem()
+
+// colorModels converts tweened colors back to the standard color types.
+var colorModels = map[reflect.Type]color.Model{
+	reflect.TypeOf(color.RGBA{}):    color.RGBAModel,
+	reflect.TypeOf(color.RGBA64{}):  color.RGBA64Model,
+	reflect.TypeOf(color.NRGBA{}):   color.NRGBAModel,
+	reflect.TypeOf(color.NRGBA64{}): color.NRGBA64Model,
+	reflect.TypeOf(color.Gray{}):    color.GrayModel,
+	reflect.TypeOf(color.Gray16{}):  color.Gray16Model,
+	reflect.TypeOf(color.Alpha{}):   color.AlphaModel,
+	reflect.TypeOf(color.Alpha16{}): color.Alpha16Model,
+}
+
+// NewStruct creates a struct updater that tweens the fields of the struct
+// target points to towards the values in to. to is either a struct, whose
+// fields are matched to target fields with the same name, or a
+// map[string]interface{} of field names to end values, with nested maps for
+// nested structs. Fields are named by their Go name or a `tween:"name"` tag,
+// and a `tween:"-"` tag leaves a field alone.
+//
+// Numbers (including time.Duration), color.Color values and structs of
+// them can be tweened; other fields of an end struct are left alone.
+// NewStruct returns ErrTarget, ErrField or ErrUnsupported if the fields
+// can't be tweened.
+func NewStruct(target, to interface{}) (*Struct, error) {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, ErrTarget
+	}
+	s := &Struct{Target: target, Done: make(chan int)}
+	fields, err := resolve(v.Elem().Type(), nil, reflect.ValueOf(to), "")
+	if err != nil {
+		return nil, err
+	}
+	s.fields = fields
+	return s, nil
+}
+
+// Struct provides tween support for the fields of a struct, writing the
+// tweened values into the struct on every frame.
+type Struct struct {
+	Target interface{} // Target is the pointer to the struct being tweened
+	Lock   sync.Locker // Lock guards writes to the struct when set
+	Done   chan int    // A channel to receive a done signal
+
+	fields []field // fields are the fields being tweened
+}
+
+// field is a struct field being tweened.
+type field struct {
+	index []int         // index finds the field with FieldByIndex
+	to    reflect.Value // to is the end value
+	from  reflect.Value // from is the starting value snapshot
+}
+
+// fieldName finds the name of a struct field, which is empty if the field
+// should not be tweened.
+func fieldName(f reflect.StructField) string {
+	if f.PkgPath != "" {
+		return ""
+	}
+	if tag, ok := f.Tag.Lookup("tween"); ok {
+		if tag == "-" {
+			return ""
+		}
+		if tag != "" {
+			return tag
+		}
+	}
+	return f.Name
+}
+
+// findField finds the field of t with a name.
+func findField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if fieldName(f) == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// resolve matches the end values in to with the fields of the struct type t.
+func resolve(t reflect.Type, index []int, to reflect.Value, path string) ([]field, error) {
+	for to.Kind() == reflect.Ptr || to.Kind() == reflect.Interface {
+		if to.IsNil() {
+			return nil, fmt.Errorf("%w %s: no end values", ErrUnsupported, strings.TrimSuffix(path, "."))
+		}
+		to = to.Elem()
+	}
+	fields := []field{}
+	add := func(name string, value reflect.Value) error {
+		f, ok := findField(t, name)
+		if !ok {
+			return fmt.Errorf("%w %s%s", ErrField, path, name)
+		}
+		i := append(append([]int(nil), index...), f.Index...)
+		nested, err := resolveField(f.Type, i, value, path+name)
+		fields = append(fields, nested...)
+		return err
+	}
+	switch to.Kind() {
+	case reflect.Map:
+		if to.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("%w %s: map keys must be strings", ErrUnsupported, path)
+		}
+		iter := to.MapRange()
+		for iter.Next() {
+			if err := add(iter.Key().String(), iter.Value()); err != nil {
+				return nil, err
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < to.NumField(); i++ {
+			if name := fieldName(to.Type().Field(i)); name != "" {
+				// Fields of an end struct that can't be tweened, like
+				// strings, are left alone
+				if err := add(name, to.Field(i)); err != nil && !errors.Is(err, ErrUnsupported) {
+					return nil, err
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w %s: end values must be a struct or map", ErrUnsupported, strings.TrimSuffix(path, "."))
+	}
+	return fields, nil
+}
+
+// resolveField checks the end value of a single field can be tweened.
+func resolveField(t reflect.Type, index []int, to reflect.Value, path string) ([]field, error) {
+	for to.Kind() == reflect.Interface {
+		if to.IsNil() {
+			return nil, fmt.Errorf("%w %s: no end value", ErrUnsupported, path)
+		}
+		to = to.Elem()
+	}
+	switch {
+	case t.Implements(colorType):
+		if !to.IsValid() || !to.Type().Implements(colorType) {
+			return nil, fmt.Errorf("%w %s: need a color", ErrUnsupported, path)
+		}
+		if _, ok := colorModels[t]; !ok && t != colorType {
+			return nil, fmt.Errorf("%w %s: unknown color type %v", ErrUnsupported, path, t)
+		}
+		return []field{{index: index, to: to}}, nil
+	case t.Kind() == reflect.Struct:
+		return resolve(t, index, to, path+".")
+	case numeric(t.Kind()):
+		if !to.IsValid() || !numeric(to.Kind()) {
+			return nil, fmt.Errorf("%w %s: need a number", ErrUnsupported, path)
+		}
+		// The end value keeps its own type, so a fractional end value for an
+		// integer field is rounded by lerp rather than truncated here
+		return []field{{index: index, to: to}}, nil
+	}
+	return nil, fmt.Errorf("%w %s: can't tween %v", ErrUnsupported, path, t)
+}
+
+// numeric reports if a kind of value is a number.
+func numeric(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
+
+// Start begins the struct update.
+func (s *Struct) Start(framerate, frames int, frameTime, runningTime time.Duration) {
+	s.lock()
+	defer s.unlock()
+	// Snapshot the starting values
+	v := reflect.ValueOf(s.Target).Elem()
+	for i := range s.fields {
+		f := &s.fields[i]
+		f.from = reflect.ValueOf(v.FieldByIndex(f.index).Interface())
+		if !f.from.IsValid() {
+			// A nil color starts transparent
+			f.from = reflect.ValueOf(color.Transparent)
+		}
+	}
+}
+
+// Update writes the interpolated values into the struct.
+func (s *Struct) Update(frame tween.Frame) {
+	s.lock()
+	defer s.unlock()
+	v := reflect.ValueOf(s.Target).Elem()
+	for _, f := range s.fields {
+		target := v.FieldByIndex(f.index)
+		target.Set(lerp(target.Type(), f.from, f.to, frame.Transitioned))
+	}
+}
+
+// End terminates the struct updates.
+func (s *Struct) End() {
+	close(s.Done)
+}
+
+func (s *Struct) lock() {
+	if s.Lock != nil {
+		s.Lock.Lock()
+	}
+}
+
+func (s *Struct) unlock() {
+	if s.Lock != nil {
+		s.Lock.Unlock()
+	}
+}
+
+// lerp interpolates between two values for a field of type t. Numbers of
+// any type are interpolated as float64 and then converted to t.
+func lerp(t reflect.Type, from, to reflect.Value, transitioned float64) reflect.Value {
+	if t.Implements(colorType) {
+		c := lerpColor(from.Interface().(color.Color), to.Interface().(color.Color), transitioned)
+		if model, ok := colorModels[t]; ok {
+			return reflect.ValueOf(model.Convert(c))
+		}
+		v := reflect.New(t).Elem()
+		v.Set(reflect.ValueOf(c))
+		return v
+	}
+	f0, f1 := toFloat(from), toFloat(to)
+	return number(t, f0+(f1-f0)*transitioned)
+}
+
+// toFloat converts a number of any type to float64.
+func toFloat(v reflect.Value) float64 {
+	return v.Convert(reflect.TypeOf(0.)).Float()
+}
+
+// number converts f to a number of type t. Integers are rounded to the
+// nearest value and clamped to the range of their type.
+func number(t reflect.Type, f float64) reflect.Value {
+	v := reflect.New(t).Elem()
+	switch {
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64:
+		f = math.Round(f)
+		limit := math.Ldexp(1, t.Bits()-1)
+		switch {
+		case f >= limit:
+			v.SetInt(int64(uint64(1)<<uint(t.Bits()-1) - 1))
+		case f < -limit:
+			v.SetInt(-1 << uint(t.Bits()-1))
+		default:
+			v.SetInt(int64(f))
+		}
+	case t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uintptr:
+		f = math.Round(f)
+		switch {
+		case f >= math.Ldexp(1, t.Bits()):
+			v.SetUint(math.MaxUint64 >> uint(64-t.Bits()))
+		case f < 0:
+			v.SetUint(0)
+		default:
+			v.SetUint(uint64(f))
+		}
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		v.SetFloat(f)
+	}
+	return v
+}
+
+// lerpColor interpolates between two colors in premultiplied 16 bit RGBA.
+func lerpColor(from, to color.Color, transitioned float64) color.RGBA64 {
+	r0, g0, b0, a0 := from.RGBA()
+	r1, g1, b1, a1 := to.RGBA()
+	channel := func(c0, c1 uint32) uint16 {
+		c := float64(c0) + (float64(c1)-float64(c0))*transitioned
+		return uint16(math.Max(0, math.Min(0xffff, math.Round(c))))
+	}
+	c := color.RGBA64{A: channel(a0, a1)}
+	// Keep the premultiplied channels within the alpha when overshooting
+	c.R = min16(channel(r0, r1), c.A)
+	c.G = min16(channel(g0, g1), c.A)
+	c.B = min16(channel(b0, b1), c.A)
+	return c
+}
+
+func min16(a, b uint16) uint16 {
+	if a < b {
+		return a
+	}
+	return b
+}
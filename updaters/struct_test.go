@@ -0,0 +1,144 @@
+package updaters_test
+
+import (
+	"errors"
+	"image/color"
+	"sync"
+	"time"
+
+	"github.com/gopackage/tween"
+	"github.com/gopackage/tween/curves"
+	. "github.com/gopackage/tween/updaters"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type Point struct {
+	X, Y float64
+}
+
+type Sprite struct {
+	Position Point
+	Opacity  float32 `tween:"alpha"`
+	Scale    int
+	Frame    uint8
+	Delay    time.Duration
+	Tint     color.RGBA
+	Fill     color.Color
+	Name     string
+	Locked   float64 `tween:"-"`
+}
+
+// at sends a single frame to an updater.
+func at(updater tween.Updater, transitioned float64) {
+	updater.Update(tween.Frame{Transitioned: transitioned})
+}
+
+var _ = Describe("Struct Tween", func() {
+	var sprite *Sprite
+	BeforeEach(func() {
+		sprite = &Sprite{
+			Position: Point{X: 10, Y: 20},
+			Opacity:  1,
+			Scale:    1,
+			Frame:    250,
+			Tint:     color.RGBA{255, 0, 0, 255},
+			Name:     "hero",
+		}
+	})
+	It("should tween fields named in a map", func() {
+		updater, err := NewStruct(sprite, map[string]interface{}{
+			"Position": map[string]interface{}{"X": 110},
+			"alpha":    0,
+			"Scale":    4,
+			"Delay":    time.Second,
+			"Tint":     color.RGBA{0, 0, 255, 255},
+			"Fill":     color.White,
+		})
+		Ω(err).Should(BeNil())
+		updater.Start(60, 60, time.Second/60, time.Second)
+		at(updater, .5)
+		Ω(sprite.Position).Should(Equal(Point{X: 60, Y: 20}))
+		Ω(sprite.Opacity).Should(Equal(float32(.5)))
+		Ω(sprite.Scale).Should(Equal(3))
+		Ω(sprite.Delay).Should(Equal(500 * time.Millisecond))
+		Ω(sprite.Tint).Should(Equal(color.RGBA{128, 0, 128, 255}))
+		// A nil color starts transparent
+		Ω(sprite.Fill).Should(Equal(color.RGBA64{0x8000, 0x8000, 0x8000, 0x8000}))
+		at(updater, 1)
+		Ω(sprite.Position).Should(Equal(Point{X: 110, Y: 20}))
+		Ω(sprite.Opacity).Should(Equal(float32(0)))
+		Ω(sprite.Scale).Should(Equal(4))
+		Ω(sprite.Tint).Should(Equal(color.RGBA{0, 0, 255, 255}))
+		Ω(sprite.Name).Should(Equal("hero"))
+	})
+	It("should tween to the fields of a struct", func() {
+		updater, err := NewStruct(sprite, Sprite{Position: Point{X: 0, Y: 0}, Frame: 255, Name: "villain", Locked: 9})
+		Ω(err).Should(BeNil())
+		updater.Start(60, 60, time.Second/60, time.Second)
+		at(updater, .5)
+		Ω(sprite.Position).Should(Equal(Point{X: 5, Y: 10}))
+		Ω(sprite.Name).Should(Equal("hero"))
+		Ω(sprite.Locked).Should(Equal(0.))
+		// Integers are clamped to their range when the curve overshoots
+		at(updater, 1.5)
+		Ω(sprite.Frame).Should(Equal(uint8(255)))
+		at(updater, -3)
+		Ω(sprite.Frame).Should(Equal(uint8(235)))
+	})
+	It("should round fractional and out of range end values for integers", func() {
+		updater, err := NewStruct(sprite, map[string]interface{}{"Scale": 2.5, "Frame": 300})
+		Ω(err).Should(BeNil())
+		updater.Start(60, 60, time.Second/60, time.Second)
+		at(updater, .2)
+		Ω(sprite.Scale).Should(Equal(1))
+		Ω(sprite.Frame).Should(Equal(uint8(255)))
+		at(updater, 1)
+		Ω(sprite.Scale).Should(Equal(3))
+		Ω(sprite.Frame).Should(Equal(uint8(255)))
+		updater, err = NewStruct(sprite, map[string]interface{}{"Frame": -1e30})
+		Ω(err).Should(BeNil())
+		updater.Start(60, 60, time.Second/60, time.Second)
+		at(updater, 1)
+		Ω(sprite.Frame).Should(Equal(uint8(0)))
+	})
+	It("should report values it can't tween", func() {
+		_, err := NewStruct(*sprite, map[string]interface{}{})
+		Ω(err).Should(Equal(ErrTarget))
+		_, err = NewStruct(sprite, map[string]interface{}{"Size": 1})
+		Ω(errors.Is(err, ErrField)).Should(BeTrue())
+		_, err = NewStruct(sprite, map[string]interface{}{"Locked": 1})
+		Ω(errors.Is(err, ErrField)).Should(BeTrue())
+		_, err = NewStruct(sprite, map[string]interface{}{"Position": map[string]interface{}{"Z": 1}})
+		Ω(err).Should(MatchError(ContainSubstring("Position.Z")))
+		_, err = NewStruct(sprite, map[string]interface{}{"Name": "villain"})
+		Ω(errors.Is(err, ErrUnsupported)).Should(BeTrue())
+		_, err = NewStruct(sprite, map[string]interface{}{"Tint": 1})
+		Ω(errors.Is(err, ErrUnsupported)).Should(BeTrue())
+		_, err = NewStruct(sprite, map[string]interface{}{"Scale": color.White})
+		Ω(errors.Is(err, ErrUnsupported)).Should(BeTrue())
+		_, err = NewStruct(sprite, 7)
+		Ω(errors.Is(err, ErrUnsupported)).Should(BeTrue())
+	})
+	It("should tween a struct with an engine", func(done Done) {
+		var mu sync.Mutex
+		updater, err := NewStruct(sprite, map[string]interface{}{"Position": Point{X: -10, Y: -20}})
+		Ω(err).Should(BeNil())
+		updater.Lock = &mu
+		engine := tween.NewEngine(100*time.Millisecond, curves.EaseInOutQuad, updater)
+		engine.Start()
+		for running := true; running; {
+			select {
+			case <-updater.Done:
+				running = false
+			case <-time.After(5 * time.Millisecond):
+				mu.Lock()
+				Ω(sprite.Position.Y).Should(BeNumerically("~", 2*sprite.Position.X, 1e-9))
+				mu.Unlock()
+			}
+		}
+		Ω(sprite.Position).Should(Equal(Point{X: -10, Y: -20}))
+		close(done)
+	}, 2)
+})
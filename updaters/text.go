@@ -0,0 +1,251 @@
+package updaters
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gopackage/tween"
+)
+
+// Graphemes splits text into user perceived characters, keeping combining
+// marks, emoji modifiers and variation selectors, zero width joiner
+// sequences, flags and CRLF together so they are revealed as one character.
+// It covers the common cases of Unicode text segmentation rather than the
+// full rules.
+func Graphemes(text string) []string {
+	clusters := []string{}
+	start := 0
+	prev := rune(-1)
+	flags := 0 // flags counts regional indicators in the current cluster
+	for i, r := range text {
+		join := i > 0 && (unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+			r == '\u200d' || prev == '\u200d' ||
+			(r >= '\ufe00' && r <= '\ufe0f') ||
+			(r >= 0x1f3fb && r <= 0x1f3ff) ||
+			(r == '\n' && prev == '\r') ||
+			(regional(r) && regional(prev) && flags%2 == 1))
+		if !join {
+			if i > 0 {
+				clusters = append(clusters, text[start:i])
+			}
+			start = i
+			flags = 0
+		}
+		if regional(r) {
+			flags++
+		}
+		prev = r
+	}
+	if start < len(text) {
+		clusters = append(clusters, text[start:])
+	}
+	return clusters
+}
+
+// regional reports if r is a regional indicator, pairs of which make flags.
+func regional(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// NewTypewriter creates a typewriter updater that reveals text and
+// initializes unbuffered channels for Updates and Done signal.
+func NewTypewriter(text string) *Typewriter {
+	return &Typewriter{
+		Text:    text,
+		Updates: make(chan string),
+		Done:    make(chan int),
+	}
+}
+
+// Typewriter reveals text one character at a time as the tween progresses.
+type Typewriter struct {
+	Text    string      // Text is the text to reveal
+	Cursor  string      // Cursor is shown after the revealed text until it is complete, e.g. "_"
+	Updates chan string // A channel that receives text updates
+	Done    chan int    // A channel to receive a done signal
+
+	clusters []string // clusters is the text snapshot split into characters
+}
+
+// Start begins the typewriter update.
+func (t *Typewriter) Start(framerate, frames int, frameTime, runningTime time.Duration) {
+	t.clusters = Graphemes(t.Text)
+}
+
+// Update reveals the transitioned share of the text.
+func (t *Typewriter) Update(frame tween.Frame) {
+	n := reveal(frame.Transitioned, len(t.clusters))
+	text := strings.Join(t.clusters[:n], "")
+	if n < len(t.clusters) {
+		text += t.Cursor
+	}
+	t.Updates <- text
+}
+
+// End terminates the typewriter updates.
+func (t *Typewriter) End() {
+	close(t.Done)
+}
+
+// reveal calculates how many of n characters are shown at transitioned.
+func reveal(transitioned float64, n int) int {
+	shown := int(math.Floor(transitioned*float64(n) + 1e-9))
+	if shown < 0 {
+		return 0
+	}
+	if shown > n {
+		return n
+	}
+	return shown
+}
+
+// DefaultCharset is the set of characters a Scramble shows when its Charset
+// is empty.
+const DefaultCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789!#$%&*+?@"
+
+// NewScramble creates a scramble updater that decodes text and initializes
+// unbuffered channels for Updates and Done signal.
+func NewScramble(text string, seed int64) *Scramble {
+	return &Scramble{
+		Text:    text,
+		Seed:    seed,
+		Updates: make(chan string),
+		Done:    make(chan int),
+	}
+}
+
+// Scramble "decodes" text: every character starts as random glyphs that
+// change each frame, and the characters settle on the text from left to
+// right as the tween progresses. Whitespace is never scrambled.
+type Scramble struct {
+	Text    string      // Text is the decoded text
+	Charset string      // Charset lists the random glyphs (defaults to DefaultCharset)
+	Seed    int64       // Seed makes the random glyphs repeatable
+	Updates chan string // A channel that receives text updates
+	Done    chan int    // A channel to receive a done signal
+
+	clusters []string // clusters is the text snapshot split into characters
+	glyphs   []string // glyphs is the charset snapshot split into characters
+}
+
+// Start begins the scramble update.
+func (s *Scramble) Start(framerate, frames int, frameTime, runningTime time.Duration) {
+	s.clusters = Graphemes(s.Text)
+	charset := s.Charset
+	if charset == "" {
+		charset = DefaultCharset
+	}
+	s.glyphs = Graphemes(charset)
+}
+
+// Update shows the decoded characters and scrambles the rest.
+func (s *Scramble) Update(frame tween.Frame) {
+	// The same frame always scrambles the same way
+	r := rand.New(rand.NewSource(s.Seed*7919 + int64(frame.Index)))
+	n := reveal(frame.Transitioned, len(s.clusters))
+	var b strings.Builder
+	for i, c := range s.clusters {
+		first, _ := utf8.DecodeRuneInString(c)
+		if i < n || unicode.IsSpace(first) {
+			b.WriteString(c)
+			continue
+		}
+		b.WriteString(s.glyphs[r.Intn(len(s.glyphs))])
+	}
+	s.Updates <- b.String()
+}
+
+// End terminates the scramble updates.
+func (s *Scramble) End() {
+	close(s.Done)
+}
+
+// NumberFormat formats numbers for display, e.g. "$1,234.56".
+type NumberFormat struct {
+	Prefix    string // Prefix is written before the number, after any minus sign, e.g. "$"
+	Suffix    string // Suffix is written after the number, e.g. "%"
+	Decimals  int    // Decimals is the number of digits after the decimal point
+	Thousands string // Thousands separates groups of three digits, e.g. ","
+	Point     string // Point is the decimal point (defaults to ".")
+}
+
+// Format formats v, rounded to Decimals.
+func (f NumberFormat) Format(v float64) string {
+	s := strconv.FormatFloat(math.Abs(v), 'f', f.Decimals, 64)
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+	if f.Thousands != "" {
+		var b strings.Builder
+		for i, d := range whole {
+			if i > 0 && (len(whole)-i)%3 == 0 {
+				b.WriteString(f.Thousands)
+			}
+			b.WriteRune(d)
+		}
+		whole = b.String()
+	}
+	sign := ""
+	if v < 0 && strings.Trim(s, "0.") != "" {
+		sign = "-"
+	}
+	out := sign + f.Prefix + whole
+	if fraction != "" {
+		point := f.Point
+		if point == "" {
+			point = "."
+		}
+		out += point + fraction
+	}
+	return out + f.Suffix
+}
+
+// NewCounter creates a counter updater that rolls a number between from and
+// to and initializes unbuffered channels for Updates and Done signal.
+func NewCounter(from, to float64, format NumberFormat) *Counter {
+	return &Counter{
+		From:    from,
+		To:      to,
+		Format:  format,
+		Updates: make(chan string),
+		Done:    make(chan int),
+	}
+}
+
+// Counter rolls a formatted number from one value to another.
+type Counter struct {
+	From    float64      // From the number we count from
+	To      float64      // To the number we count to
+	Format  NumberFormat // Format formats the number
+	Updates chan string  // A channel that receives text updates
+	Done    chan int     // A channel to receive a done signal
+
+	from float64 // from is the starting number snapshot
+	to   float64 // to is the ending number snapshot
+}
+
+// Start begins the counter update.
+func (c *Counter) Start(framerate, frames int, frameTime, runningTime time.Duration) {
+	c.from = c.From
+	c.to = c.To
+}
+
+// Update formats the number between start and end.
+func (c *Counter) Update(frame tween.Frame) {
+	v := c.from + (c.to-c.from)*frame.Transitioned
+	if frame.Transitioned == 1 {
+		v = c.to
+	}
+	c.Updates <- c.Format.Format(v)
+}
+
+// End terminates the counter updates.
+func (c *Counter) End() {
+	close(c.Done)
+}
@@ -0,0 +1,88 @@
+package updaters_test
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gopackage/tween"
+	"github.com/gopackage/tween/curves"
+	. "github.com/gopackage/tween/updaters"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// text sends a frame to a text updater and receives the text it produces.
+func text(updater tween.Updater, updates chan string, index int, transitioned float64) string {
+	go updater.Update(tween.Frame{Index: index, Transitioned: transitioned})
+	return <-updates
+}
+
+var _ = Describe("Text Tween", func() {
+	It("should split text into characters", func() {
+		Ω(Graphemes("")).Should(BeEmpty())
+		Ω(Graphemes("he\u0301llo")).Should(Equal([]string{"h", "e\u0301", "l", "l", "o"}))
+		Ω(Graphemes("héllo")).Should(Equal([]string{"h", "é", "l", "l", "o"}))
+		Ω(Graphemes("a👩‍👩‍👧b")).Should(Equal([]string{"a", "👩‍👩‍👧", "b"}))
+		Ω(Graphemes("👍🏽❤️")).Should(Equal([]string{"👍🏽", "❤️"}))
+		Ω(Graphemes("🇳🇿🇯🇵")).Should(Equal([]string{"🇳🇿", "🇯🇵"}))
+		Ω(Graphemes("a\r\nb")).Should(Equal([]string{"a", "\r\n", "b"}))
+	})
+	It("should type text", func() {
+		updater := NewTypewriter("Cé 👍🏽!")
+		updater.Cursor = "_"
+		updater.Start(60, 60, time.Second/60, time.Second)
+		Ω(text(updater, updater.Updates, 0, 0)).Should(Equal("_"))
+		Ω(text(updater, updater.Updates, 1, .4)).Should(Equal("Cé_"))
+		Ω(text(updater, updater.Updates, 2, .8)).Should(Equal("Cé 👍🏽_"))
+		Ω(text(updater, updater.Updates, 3, 1)).Should(Equal("Cé 👍🏽!"))
+		Ω(text(updater, updater.Updates, 4, 1.2)).Should(Equal("Cé 👍🏽!"))
+		Ω(text(updater, updater.Updates, 5, -.2)).Should(Equal("_"))
+	})
+	It("should decode scrambled text", func() {
+		updater := NewScramble("Access granted", 42)
+		updater.Charset = "01"
+		updater.Start(60, 60, time.Second/60, time.Second)
+		start := text(updater, updater.Updates, 0, 0)
+		Ω(utf8.RuneCountInString(start)).Should(Equal(14))
+		Ω(start[6]).Should(Equal(byte(' ')))
+		Ω(strings.Trim(start, "01 ")).Should(BeEmpty())
+		// The same frame scrambles the same way
+		Ω(text(updater, updater.Updates, 0, 0)).Should(Equal(start))
+		Ω(text(updater, updater.Updates, 1, .5)).Should(HavePrefix("Access "))
+		Ω(text(updater, updater.Updates, 2, 1)).Should(Equal("Access granted"))
+		other := NewScramble("Access granted", 7)
+		other.Charset = "01"
+		other.Start(60, 60, time.Second/60, time.Second)
+		Ω(text(other, other.Updates, 0, 0)).ShouldNot(Equal(start))
+	})
+	It("should format numbers", func() {
+		money := NumberFormat{Prefix: "$", Decimals: 2, Thousands: ","}
+		Ω(money.Format(1234.56)).Should(Equal("$1,234.56"))
+		Ω(money.Format(-1234567.891)).Should(Equal("-$1,234,567.89"))
+		Ω(money.Format(999.999)).Should(Equal("$1,000.00"))
+		Ω(money.Format(-.001)).Should(Equal("$0.00"))
+		Ω(NumberFormat{}.Format(41.5)).Should(Equal("42"))
+		euro := NumberFormat{Suffix: " €", Decimals: 1, Thousands: ".", Point: ","}
+		Ω(euro.Format(12345.67)).Should(Equal("12.345,7 €"))
+		Ω(NumberFormat{Suffix: "%"}.Format(100)).Should(Equal("100%"))
+	})
+	It("should count between numbers", func(done Done) {
+		updater := NewCounter(0, 1234.56, NumberFormat{Prefix: "$", Decimals: 2, Thousands: ","})
+		engine := tween.NewEngine(100*time.Millisecond, curves.EaseOutQuad, updater)
+		engine.Start()
+		counts := []string{}
+		for running := true; running; {
+			select {
+			case count := <-updater.Updates:
+				counts = append(counts, count)
+			case <-updater.Done:
+				running = false
+			}
+		}
+		Ω(counts[0]).Should(Equal("$0.00"))
+		Ω(counts[len(counts)-1]).Should(Equal("$1,234.56"))
+		close(done)
+	}, 2)
+})
@@ -0,0 +1,118 @@
+package updaters
+
+import (
+	"math"
+	"time"
+
+	"github.com/gopackage/tween"
+)
+
+// NewTime creates a new time updater with the provided times and
+// initializes unbuffered channels for Updates and Done signal.
+func NewTime(from, to time.Time) *Time {
+	return &Time{
+		From:    from,
+		To:      to,
+		Updates: make(chan time.Time),
+		Done:    make(chan int),
+	}
+}
+
+// Time provides tween support for times, e.g. to animate a clock between two
+// timestamps. Times are interpolated on the wall clock, ignoring any
+// monotonic clock reading, and are sent in the location of From so the
+// time zone doesn't change part way through.
+type Time struct {
+	From    time.Time      // From the time we transition from
+	To      time.Time      // To the time we transition to
+	Round   time.Duration  // Round rounds each update to a multiple of this duration (as time.Time.Round) when set
+	Updates chan time.Time // A channel that receives time updates
+	Done    chan int       // A channel to receive a done signal
+
+	from    time.Time // from is the starting time snapshot
+	seconds float64   // seconds is the whole seconds between the times
+	nanos   float64   // nanos is the remaining nanoseconds between the times
+}
+
+// Start begins the time update.
+func (t *Time) Start(framerate, frames int, frameTime, runningTime time.Duration) {
+	// Snapshot the times without monotonic clock readings - just in case
+	// someone tries to change them
+	t.from = t.From.Round(0)
+	to := t.To.Round(0)
+	// Split the difference so times centuries apart don't overflow
+	t.seconds = float64(to.Unix() - t.from.Unix())
+	t.nanos = float64(to.Nanosecond() - t.from.Nanosecond())
+}
+
+// Update interpolates the time between start and end.
+func (t *Time) Update(frame tween.Frame) {
+	s, frac := math.Modf(t.seconds * frame.Transitioned)
+	ns := math.Round(frac*1e9 + t.nanos*frame.Transitioned)
+	v := time.Unix(t.from.Unix()+int64(s), int64(t.from.Nanosecond())+int64(ns)).In(t.from.Location())
+	if t.Round > 0 {
+		v = v.Round(t.Round)
+	}
+	t.Updates <- v
+}
+
+// End terminates the time updates.
+func (t *Time) End() {
+	close(t.Done)
+}
+
+// NewDuration creates a new duration updater with the provided durations and
+// initializes unbuffered channels for Updates and Done signal.
+func NewDuration(from, to time.Duration) *Duration {
+	return &Duration{
+		From:    from,
+		To:      to,
+		Updates: make(chan time.Duration),
+		Done:    make(chan int),
+	}
+}
+
+// Duration provides tween support for durations, e.g. to animate a
+// countdown.
+type Duration struct {
+	From    time.Duration      // From the duration we transition from
+	To      time.Duration      // To the duration we transition to
+	Round   time.Duration      // Round rounds each update to a multiple of this duration (as time.Duration.Round) when set
+	Updates chan time.Duration // A channel that receives duration updates
+	Done    chan int           // A channel to receive a done signal
+
+	from time.Duration // from is the starting duration snapshot
+	to   time.Duration // to is the ending duration snapshot
+}
+
+// Start begins the duration update.
+func (d *Duration) Start(framerate, frames int, frameTime, runningTime time.Duration) {
+	d.from = d.From
+	d.to = d.To
+}
+
+// Update interpolates the duration between start and end, clamped to the
+// range of time.Duration when the curve overshoots.
+func (d *Duration) Update(frame tween.Frame) {
+	f := math.Round(float64(d.from) + (float64(d.to)-float64(d.from))*frame.Transitioned)
+	v := time.Duration(f)
+	switch {
+	case frame.Transitioned == 0:
+		v = d.from
+	case frame.Transitioned == 1:
+		v = d.to
+	case f >= math.MaxInt64:
+		v = math.MaxInt64
+	case f <= math.MinInt64:
+		v = math.MinInt64
+	}
+	if d.Round > 0 {
+		v = v.Round(d.Round)
+	}
+	d.Updates <- v
+}
+
+// End terminates the duration updates.
+func (d *Duration) End() {
+	close(d.Done)
+}
@@ -0,0 +1,102 @@
+package updaters_test
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gopackage/tween"
+	"github.com/gopackage/tween/curves"
+	. "github.com/gopackage/tween/updaters"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Time Tween", func() {
+	// next sends a frame and receives the time it produces
+	next := func(updater *Time, transitioned float64) time.Time {
+		go at(updater, transitioned)
+		return <-updater.Updates
+	}
+	It("should interpolate times", func() {
+		est := time.FixedZone("EST", -5*60*60)
+		from := time.Date(2020, 3, 8, 0, 0, 0, 0, est)
+		to := time.Date(2020, 3, 8, 15, 0, 0, 500, time.UTC)
+		updater := NewTime(from, to)
+		updater.Start(60, 60, time.Second/60, time.Second)
+		Ω(next(updater, 0)).Should(Equal(from))
+		mid := next(updater, .5)
+		Ω(mid).Should(Equal(time.Date(2020, 3, 8, 5, 0, 0, 250, est)))
+		Ω(mid.Location()).Should(Equal(est))
+		Ω(next(updater, 1).Equal(to)).Should(BeTrue())
+		Ω(next(updater, 1.5)).Should(Equal(time.Date(2020, 3, 8, 15, 0, 0, 750, est)))
+	})
+	It("should ignore monotonic clock readings", func() {
+		from := time.Now()
+		updater := NewTime(from, from.Add(time.Hour))
+		updater.Start(60, 60, time.Second/60, time.Second)
+		mid := next(updater, .5)
+		Ω(mid.Equal(from.Add(30 * time.Minute))).Should(BeTrue())
+		Ω(strings.Contains(mid.String(), "m=")).Should(BeFalse())
+	})
+	It("should interpolate times centuries apart", func() {
+		from := time.Date(1500, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2500, 1, 1, 0, 0, 0, 0, time.UTC)
+		updater := NewTime(from, to)
+		updater.Start(60, 60, time.Second/60, time.Second)
+		mid := next(updater, .5)
+		Ω(mid.Unix()).Should(Equal((from.Unix() + to.Unix()) / 2))
+		Ω(next(updater, 1)).Should(Equal(to))
+	})
+	It("should round times", func() {
+		from := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+		updater := NewTime(from, from.Add(time.Hour))
+		updater.Round = time.Minute
+		updater.Start(60, 60, time.Second/60, time.Second)
+		Ω(next(updater, .123)).Should(Equal(from.Add(7 * time.Minute)))
+	})
+})
+
+var _ = Describe("Duration Tween", func() {
+	// next sends a frame and receives the duration it produces
+	next := func(updater *Duration, transitioned float64) time.Duration {
+		go at(updater, transitioned)
+		return <-updater.Updates
+	}
+	It("should interpolate durations", func() {
+		updater := NewDuration(10*time.Minute, 0)
+		updater.Round = time.Second
+		updater.Start(60, 60, time.Second/60, time.Second)
+		Ω(next(updater, 0)).Should(Equal(10 * time.Minute))
+		Ω(next(updater, .5)).Should(Equal(5 * time.Minute))
+		Ω(next(updater, .12345)).Should(Equal(8*time.Minute + 46*time.Second))
+		Ω(next(updater, 1)).Should(Equal(time.Duration(0)))
+	})
+	It("should clamp overshooting durations", func() {
+		updater := NewDuration(0, math.MaxInt64)
+		updater.Start(60, 60, time.Second/60, time.Second)
+		Ω(next(updater, 1.5)).Should(Equal(time.Duration(math.MaxInt64)))
+		Ω(next(updater, -1.5)).Should(Equal(time.Duration(math.MinInt64)))
+		Ω(next(updater, 1)).Should(Equal(time.Duration(math.MaxInt64)))
+	})
+	It("should count down with an engine", func(done Done) {
+		updater := NewDuration(3*time.Second, 0)
+		updater.Round = time.Second
+		engine := tween.NewEngine(100*time.Millisecond, curves.Linear, updater)
+		engine.Start()
+		durations := []time.Duration{}
+		for running := true; running; {
+			select {
+			case d := <-updater.Updates:
+				durations = append(durations, d)
+			case <-updater.Done:
+				running = false
+			}
+		}
+		Ω(durations[0]).Should(Equal(3 * time.Second))
+		Ω(durations[len(durations)-1]).Should(Equal(time.Duration(0)))
+		Ω(durations).Should(ContainElement(2 * time.Second))
+		close(done)
+	}, 2)
+})
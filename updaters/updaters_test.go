@@ -37,5 +37,52 @@ var _ = Describe("Core", func() {
 			Ω(colors[0]).Should(Equal(start))
 			close(done)
 		}, 2)
+		It("should retarget without jumping", func() {
+			updater := NewColor(color.RGBA{0, 0, 0, 255}, color.RGBA{200, 0, 0, 255})
+			updater.Updates = make(chan color.RGBA, 61)
+			frameTime := time.Second / 60
+			updater.Start(60, 60, frameTime, time.Second)
+			reds := []int{}
+			for i := 0; i <= 60; i++ {
+				if i == 31 {
+					updater.Retarget(color.RGBA{100, 50, 0, 255})
+				}
+				updater.Update(tween.Frame{
+					Index:        i,
+					Elapsed:      time.Duration(i) * frameTime,
+					Completed:    float64(i) / 60,
+					Transitioned: float64(i) / 60,
+				})
+				reds = append(reds, int((<-updater.Updates).R))
+			}
+			Ω(reds[30]).Should(Equal(100))
+			// The tween keeps its speed and overshoots before settling
+			Ω(reds[31] - reds[30]).Should(BeNumerically("~", 3, 1))
+			Ω(reds[32] - reds[31]).Should(BeNumerically("~", 3, 1))
+			Ω(reds[45]).Should(BeNumerically(">", 100))
+			Ω(reds[60]).Should(Equal(100))
+			Ω(updater.To).Should(Equal(color.RGBA{100, 50, 0, 255}))
+		})
+		It("should retarget a running tween", func(done Done) {
+			start := color.RGBA{255, 0, 0, 255}
+			end := color.RGBA{0, 128, 255, 0}
+			updater := NewColor(start, end)
+			engine := tween.NewEngine(200*time.Millisecond, curves.EaseInOutQuad, updater)
+			engine.Start()
+			colors := []color.RGBA{}
+			for running := true; running; {
+				select {
+				case color := <-updater.Updates:
+					colors = append(colors, color)
+					if len(colors) == 5 {
+						updater.Retarget(start)
+					}
+				case <-updater.Done:
+					running = false
+				}
+			}
+			Ω(colors[len(colors)-1]).Should(Equal(start))
+			close(done)
+		}, 2)
 	})
 })
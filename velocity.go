@@ -0,0 +1,151 @@
+package tween
+
+import (
+	"math"
+	"time"
+)
+
+// Estimator selects how a VelocityTracker estimates velocity.
+type Estimator int
+
+const (
+	// LeastSquares fits a quadratic to the recent positions and uses its
+	// slope at the latest sample (the default). It smooths out noisy input.
+	LeastSquares Estimator = iota
+	// Impulse treats each movement as an impulse on a unit mass and uses the
+	// velocity of the resulting kinetic energy. It reacts quickly to flicks.
+	Impulse
+)
+
+// DefaultVelocityWindow is how far back a VelocityTracker looks when its
+// Window is not set.
+const DefaultVelocityWindow = 100 * time.Millisecond
+
+// VelocityTracker estimates the release velocity of pointer or gesture input
+// from timestamped positions, to start a Decay or a spring curve where the
+// gesture left off. Track each axis of a two dimensional gesture with its own
+// tracker.
+type VelocityTracker struct {
+	Window    time.Duration // Window is how far back from the latest sample positions are used (defaults to DefaultVelocityWindow).
+	Estimator Estimator     // Estimator selects least squares (default) or impulse estimation.
+
+	samples []velocitySample // samples are the positions within the window, oldest first
+}
+
+// velocitySample is a position at a point in time.
+type velocitySample struct {
+	at       time.Time
+	position float64
+}
+
+// Add records the position of the input at a point in time. Samples should
+// be added in time order, a sample older than the latest one starts again.
+func (v *VelocityTracker) Add(at time.Time, position float64) {
+	if n := len(v.samples); n > 0 && at.Before(v.samples[n-1].at) {
+		v.samples = v.samples[:0]
+	}
+	v.samples = append(v.samples, velocitySample{at: at, position: position})
+	window := v.Window
+	if window <= 0 {
+		window = DefaultVelocityWindow
+	}
+	// Forget samples that have fallen out of the window
+	first := 0
+	for at.Sub(v.samples[first].at) > window {
+		first++
+	}
+	v.samples = append(v.samples[:0], v.samples[first:]...)
+}
+
+// Reset forgets every sample, e.g. when a new gesture begins.
+func (v *VelocityTracker) Reset() {
+	v.samples = v.samples[:0]
+}
+
+// Velocity estimates the velocity at the latest sample in units per second.
+// It is 0 until there are two samples at different times.
+func (v *VelocityTracker) Velocity() float64 {
+	if len(v.samples) < 2 {
+		return 0
+	}
+	// Times are in seconds relative to the latest sample
+	latest := v.samples[len(v.samples)-1].at
+	t := make([]float64, len(v.samples))
+	x := make([]float64, len(v.samples))
+	for i, s := range v.samples {
+		t[i] = s.at.Sub(latest).Seconds()
+		x[i] = s.position
+	}
+	if v.Estimator == Impulse {
+		return impulse(t, x)
+	}
+	return leastSquares(t, x)
+}
+
+// Relative converts the velocity into the fraction of the distance from
+// from to to covered per second, the initial velocity used by spring curves
+// that move from 0 to 1.
+func (v *VelocityTracker) Relative(from, to float64) float64 {
+	if from == to {
+		return 0
+	}
+	return v.Velocity() / (to - from)
+}
+
+// leastSquares fits x = a + b*t + c*t*t to the samples and returns b, the
+// slope at t = 0. Too few distinct times fall back to a straight line.
+func leastSquares(t, x []float64) float64 {
+	var s [5]float64 // s[k] is the sum of t^k
+	var r [3]float64 // r[k] is the sum of x*t^k
+	for i := range t {
+		p := 1.
+		for k := 0; k < 5; k++ {
+			s[k] += p
+			if k < 3 {
+				r[k] += x[i] * p
+			}
+			p *= t[i]
+		}
+	}
+	if len(t) > 2 {
+		// Solve the normal equations with Cramer's rule
+		det := s[0]*(s[2]*s[4]-s[3]*s[3]) - s[1]*(s[1]*s[4]-s[3]*s[2]) + s[2]*(s[1]*s[3]-s[2]*s[2])
+		if math.Abs(det) > 1e-18 {
+			b := s[0]*(r[1]*s[4]-s[3]*r[2]) - r[0]*(s[1]*s[4]-s[3]*s[2]) + s[2]*(s[1]*r[2]-r[1]*s[2])
+			return b / det
+		}
+	}
+	det := s[0]*s[2] - s[1]*s[1]
+	if math.Abs(det) < 1e-18 {
+		return 0
+	}
+	return (s[0]*r[1] - s[1]*r[0]) / det
+}
+
+// impulse estimates the velocity from the kinetic energy imparted by each
+// movement between samples.
+func impulse(t, x []float64) float64 {
+	energy := func(work float64) float64 {
+		if work < 0 {
+			return -math.Sqrt(-2 * work)
+		}
+		return math.Sqrt(2 * work)
+	}
+	work := 0.
+	moved := false
+	for i := 1; i < len(t); i++ {
+		dt := t[i] - t[i-1]
+		if dt <= 0 {
+			continue
+		}
+		current := (x[i] - x[i-1]) / dt
+		previous := energy(work)
+		work += (current - previous) * math.Abs(current)
+		if !moved {
+			// The first movement starts from rest
+			work *= .5
+			moved = true
+		}
+	}
+	return energy(work)
+}
@@ -0,0 +1,71 @@
+package tween_test
+
+import (
+	"time"
+
+	. "github.com/gopackage/tween"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// track adds positions to a tracker every 8ms starting at start.
+func track(tracker *VelocityTracker, start time.Time, position func(t float64) float64, samples int) time.Time {
+	at := start
+	for i := 0; i < samples; i++ {
+		at = start.Add(time.Duration(i) * 8 * time.Millisecond)
+		tracker.Add(at, position(at.Sub(start).Seconds()))
+	}
+	return at
+}
+
+var _ = Describe("VelocityTracker", func() {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	It("should need two samples", func() {
+		tracker := &VelocityTracker{}
+		Ω(tracker.Velocity()).Should(Equal(0.))
+		tracker.Add(start, 10)
+		Ω(tracker.Velocity()).Should(Equal(0.))
+		tracker.Add(start, 20)
+		Ω(tracker.Velocity()).Should(Equal(0.))
+	})
+	It("should estimate a steady velocity", func() {
+		for _, estimator := range []Estimator{LeastSquares, Impulse} {
+			tracker := &VelocityTracker{Estimator: estimator}
+			track(tracker, start, func(t float64) float64 { return 20 + 500*t }, 10)
+			Ω(tracker.Velocity()).Should(BeNumerically("~", 500, 1e-6))
+			Ω(tracker.Relative(100, 0)).Should(BeNumerically("~", -5, 1e-6))
+		}
+	})
+	It("should fit accelerating motion", func() {
+		tracker := &VelocityTracker{}
+		last := track(tracker, start, func(t float64) float64 { return 1000 * t * t }, 10)
+		Ω(tracker.Velocity()).Should(BeNumerically("~", 2000*last.Sub(start).Seconds(), 1e-6))
+		// The impulse estimate lags behind but moves the same way
+		tracker.Estimator = Impulse
+		Ω(tracker.Velocity()).Should(BeNumerically(">", 0))
+		Ω(tracker.Velocity()).Should(BeNumerically("<", 2000*last.Sub(start).Seconds()))
+	})
+	It("should only use recent samples", func() {
+		tracker := &VelocityTracker{Window: 50 * time.Millisecond}
+		last := track(tracker, start, func(t float64) float64 { return -300 * t }, 20)
+		tracker.Add(last.Add(time.Second), 0)
+		tracker.Add(last.Add(time.Second+10*time.Millisecond), 4)
+		Ω(tracker.Velocity()).Should(BeNumerically("~", 400, 1e-6))
+	})
+	It("should start again", func() {
+		tracker := &VelocityTracker{}
+		track(tracker, start, func(t float64) float64 { return 300 * t }, 5)
+		tracker.Reset()
+		Ω(tracker.Velocity()).Should(Equal(0.))
+		track(tracker, start.Add(time.Second), func(t float64) float64 { return 100 * t }, 5)
+		track(tracker, start, func(t float64) float64 { return -100 * t }, 5)
+		Ω(tracker.Velocity()).Should(BeNumerically("~", -100, 1e-6))
+	})
+	It("should start a decay", func() {
+		tracker := &VelocityTracker{}
+		track(tracker, start, func(t float64) float64 { return 800 * t }, 10)
+		decay := Decay{From: 72, Velocity: tracker.Velocity()}
+		Ω(decay.To()).Should(BeNumerically("~", 72+800*.325, 1e-6))
+	})
+})